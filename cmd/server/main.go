@@ -33,6 +33,19 @@ func main() {
 	}
 	logger.Info().Msg("🌍 Geocoding service initialized successfully")
 
+	// Register custom aspects before the services create their calculators
+	if cfg.Aspects.CustomAspectsFile != "" {
+		count, err := astro.LoadCustomAspects(cfg.Aspects.CustomAspectsFile)
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Str("file", cfg.Aspects.CustomAspectsFile).
+				Msg("Failed to load custom aspects")
+			log.Fatalf("Failed to load custom aspects: %v", err)
+		}
+		logger.Info().Int("custom_aspects", count).Msg("📐 Custom aspects registered")
+	}
+
 	// Initialize services
 	natalService := service.NewNatalService(logger)
 	synastryService := service.NewSynastryService(logger)
@@ -40,6 +53,13 @@ func main() {
 	solarReturnService := service.NewSolarReturnService(logger)
 	lunarReturnService := service.NewLunarReturnService(logger)
 	progressionsService := service.NewProgressionsService(logger)
+	vedicService := service.NewVedicService(logger)
+	panchangaService := service.NewPanchangaService(logger)
+	kpService := service.NewKPService(logger)
+	fixedStarsService := service.NewFixedStarsService(logger)
+	midpointsService := service.NewMidpointsService(logger)
+	harmonicsService := service.NewHarmonicsService(logger)
+	astrocartographyService := service.NewAstrocartographyService(logger)
 
 	logger.Info().Msg("✅ All services initialized successfully")
 
@@ -64,6 +84,13 @@ func main() {
 		solarReturnService,
 		lunarReturnService,
 		progressionsService,
+		vedicService,
+		panchangaService,
+		kpService,
+		fixedStarsService,
+		midpointsService,
+		harmonicsService,
+		astrocartographyService,
 		logger,
 	)
 
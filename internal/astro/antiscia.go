@@ -0,0 +1,71 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+)
+
+// DefaultAntisciaOrb is the default orb in degrees for antiscia contacts
+const DefaultAntisciaOrb = 1.0
+
+// AntisciaCalculator handles antiscia and contra-antiscia
+type AntisciaCalculator struct{}
+
+// NewAntisciaCalculator creates a new antiscia calculator
+func NewAntisciaCalculator() *AntisciaCalculator {
+	return &AntisciaCalculator{}
+}
+
+// CalculateAntiscia calculates the antiscia of every planet and of the Ascendant and Midheaven
+func (ac *AntisciaCalculator) CalculateAntiscia(
+	planets []domain.Planet,
+	ascendant, midheaven float64,
+) []domain.AntisciaPoint {
+
+	var points []domain.AntisciaPoint
+	for _, planet := range planets {
+		points = append(points, domain.NewAntisciaPoint(planet.Name, planet.Longitude))
+	}
+	points = append(points,
+		domain.NewAntisciaPoint("Ascendant", ascendant),
+		domain.NewAntisciaPoint("Midheaven", midheaven),
+	)
+
+	return points
+}
+
+// CalculateAntisciaAspects finds points falling on another point's antiscia within a chart
+func (ac *AntisciaCalculator) CalculateAntisciaAspects(points []domain.AntisciaPoint, orb float64) []domain.AntisciaAspect {
+	var aspects []domain.AntisciaAspect
+
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			// Reflections are symmetric, so one direction per pair is enough
+			aspect := domain.NewAntisciaAspect(points[i], points[j], orb)
+			if aspect != nil {
+				aspects = append(aspects, *aspect)
+			}
+		}
+	}
+
+	return aspects
+}
+
+// CalculateAntisciaAspectsBetweenCharts finds points of one chart falling on the antiscia of another
+func (ac *AntisciaCalculator) CalculateAntisciaAspectsBetweenCharts(
+	chart1Points, chart2Points []domain.AntisciaPoint,
+	orb float64,
+) []domain.AntisciaAspect {
+
+	var aspects []domain.AntisciaAspect
+
+	for _, point1 := range chart1Points {
+		for _, point2 := range chart2Points {
+			aspect := domain.NewAntisciaAspect(point1, point2, orb)
+			if aspect != nil {
+				aspects = append(aspects, *aspect)
+			}
+		}
+	}
+
+	return aspects
+}
@@ -0,0 +1,137 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+)
+
+// ashtakavargaPlanets lists the seven planets that have an ashtakavarga, in traditional order
+var ashtakavargaPlanets = []string{"Sun", "Moon", "Mars", "Mercury", "Jupiter", "Venus", "Saturn"}
+
+// ashtakavargaContributors lists the eight reference points of every ashtakavarga
+var ashtakavargaContributors = []string{"Sun", "Moon", "Mars", "Mercury", "Jupiter", "Venus", "Saturn", "Ascendant"}
+
+// ashtakavargaBenefics lists, for each planet's ashtakavarga, the houses counted from each
+// reference point that receive a bindu (Brihat Parashara Hora Shastra)
+var ashtakavargaBenefics = map[string]map[string][]int{
+	"Sun": {
+		"Sun":       {1, 2, 4, 7, 8, 9, 10, 11},
+		"Moon":      {3, 6, 10, 11},
+		"Mars":      {1, 2, 4, 7, 8, 9, 10, 11},
+		"Mercury":   {3, 5, 6, 9, 10, 11, 12},
+		"Jupiter":   {5, 6, 9, 11},
+		"Venus":     {6, 7, 12},
+		"Saturn":    {1, 2, 4, 7, 8, 9, 10, 11},
+		"Ascendant": {3, 4, 6, 10, 11, 12},
+	},
+	"Moon": {
+		"Sun":       {3, 6, 7, 8, 10, 11},
+		"Moon":      {1, 3, 6, 7, 10, 11},
+		"Mars":      {2, 3, 5, 6, 9, 10, 11},
+		"Mercury":   {1, 3, 4, 5, 7, 8, 10, 11},
+		"Jupiter":   {1, 4, 7, 8, 10, 11, 12},
+		"Venus":     {3, 4, 5, 7, 9, 10, 11},
+		"Saturn":    {3, 5, 6, 11},
+		"Ascendant": {3, 6, 10, 11},
+	},
+	"Mars": {
+		"Sun":       {3, 5, 6, 10, 11},
+		"Moon":      {3, 6, 11},
+		"Mars":      {1, 2, 4, 7, 8, 10, 11},
+		"Mercury":   {3, 5, 6, 11},
+		"Jupiter":   {6, 10, 11, 12},
+		"Venus":     {6, 8, 11, 12},
+		"Saturn":    {1, 4, 7, 8, 9, 10, 11},
+		"Ascendant": {1, 3, 6, 10, 11},
+	},
+	"Mercury": {
+		"Sun":       {5, 6, 9, 11, 12},
+		"Moon":      {2, 4, 6, 8, 10, 11},
+		"Mars":      {1, 2, 4, 7, 8, 9, 10, 11},
+		"Mercury":   {1, 3, 5, 6, 9, 10, 11, 12},
+		"Jupiter":   {6, 8, 11, 12},
+		"Venus":     {1, 2, 3, 4, 5, 8, 9, 11},
+		"Saturn":    {1, 2, 4, 7, 8, 9, 10, 11},
+		"Ascendant": {1, 2, 4, 6, 8, 10, 11},
+	},
+	"Jupiter": {
+		"Sun":       {1, 2, 3, 4, 7, 8, 9, 10, 11},
+		"Moon":      {2, 5, 7, 9, 11},
+		"Mars":      {1, 2, 4, 7, 8, 10, 11},
+		"Mercury":   {1, 2, 4, 5, 6, 9, 10, 11},
+		"Jupiter":   {1, 2, 3, 4, 7, 8, 10, 11},
+		"Venus":     {2, 5, 6, 9, 10, 11},
+		"Saturn":    {3, 5, 6, 12},
+		"Ascendant": {1, 2, 4, 5, 6, 7, 9, 10, 11},
+	},
+	"Venus": {
+		"Sun":       {8, 11, 12},
+		"Moon":      {1, 2, 3, 4, 5, 8, 9, 11, 12},
+		"Mars":      {3, 5, 6, 9, 11, 12},
+		"Mercury":   {3, 5, 6, 9, 11},
+		"Jupiter":   {5, 8, 9, 10, 11},
+		"Venus":     {1, 2, 3, 4, 5, 8, 9, 10, 11},
+		"Saturn":    {3, 4, 5, 8, 9, 10, 11},
+		"Ascendant": {1, 2, 3, 4, 5, 8, 9, 11},
+	},
+	"Saturn": {
+		"Sun":       {1, 2, 4, 7, 8, 10, 11},
+		"Moon":      {3, 6, 11},
+		"Mars":      {3, 5, 6, 10, 11, 12},
+		"Mercury":   {6, 8, 9, 10, 11, 12},
+		"Jupiter":   {5, 6, 11, 12},
+		"Venus":     {6, 11, 12},
+		"Saturn":    {3, 5, 6, 11},
+		"Ascendant": {1, 3, 4, 6, 10, 11},
+	},
+}
+
+// CalculateAshtakavarga calculates the Bhinna and Sarva ashtakavarga from sidereal rasi positions
+func (vc *VedicCalculator) CalculateAshtakavarga(positions *SiderealPositions) (*domain.Ashtakavarga, error) {
+	// Sign index (0-11) of every reference point
+	signs := map[string]int{
+		"Ascendant": int(normalizeAngle360(positions.Ascendant) / 30.0),
+	}
+	for _, planet := range positions.Planets {
+		signs[planet.Name] = int(normalizeAngle360(planet.Longitude) / 30.0)
+	}
+	for _, contributor := range ashtakavargaContributors {
+		if _, exists := signs[contributor]; !exists {
+			return nil, fmt.Errorf("missing position for %s", contributor)
+		}
+	}
+
+	result := &domain.Ashtakavarga{
+		Sarva: make([]domain.AshtakavargaSign, 12),
+	}
+	for i := 0; i < 12; i++ {
+		result.Sarva[i].Sign = domain.GetSignByNumber(i + 1)
+	}
+
+	for _, planet := range ashtakavargaPlanets {
+		bhinna := domain.BhinnaAshtakavarga{
+			Planet: planet,
+			Signs:  make([]domain.AshtakavargaSign, 12),
+		}
+		for i := 0; i < 12; i++ {
+			bhinna.Signs[i].Sign = domain.GetSignByNumber(i + 1)
+		}
+
+		for _, contributor := range ashtakavargaContributors {
+			for _, house := range ashtakavargaBenefics[planet][contributor] {
+				sign := (signs[contributor] + house - 1) % 12
+				bhinna.Signs[sign].Bindus++
+				bhinna.Signs[sign].Contributors = append(bhinna.Signs[sign].Contributors, contributor)
+			}
+		}
+
+		for i := 0; i < 12; i++ {
+			bhinna.Total += bhinna.Signs[i].Bindus
+			result.Sarva[i].Bindus += bhinna.Signs[i].Bindus
+		}
+		result.Total += bhinna.Total
+		result.Bhinna = append(result.Bhinna, bhinna)
+	}
+
+	return result, nil
+}
@@ -2,23 +2,199 @@ package astro
 
 import (
 	"astroeph-api/internal/domain"
+	"encoding/json"
+	"fmt"
+	"os"
 )
 
 // AspectCalculator handles aspect-related calculations
 type AspectCalculator struct {
 	// Configuration for aspect calculations
+	definitions    []domain.AspectDefinition
 	aspectOrbs     map[domain.AspectType]float64
 	planetOrbs     map[string]float64
 	enabledAspects map[domain.AspectType]bool
+	pointOrbs      map[domain.PlanetType]float64 // maximum orb of aspects to sensitive points
+	moiety         bool                          // planetOrbs hold full planetary orbs and aspect orbs are the sum of moieties
+	explicitOrbs   map[domain.AspectType]bool    // aspects whose orb was set by the caller; they keep it under moieties
+}
+
+// Orb profiles accepted by NewAspectCalculatorWithSettings
+const (
+	OrbProfileStandard = "standard" // base orb per aspect plus per-planet adjustments
+	OrbProfileTight    = "tight"    // narrower base orbs, only the luminaries get extra orb
+	OrbProfileWide     = "wide"     // wider base orbs with per-planet adjustments
+	OrbProfileMoiety   = "moiety"   // traditional moieties: half the sum of both planets' orbs
+)
+
+// DefaultExactWindowDays is how far from the chart time the date of an aspect's perfection is reported
+const DefaultExactWindowDays = 30.0
+
+// defaultMoietyOrb is the full orb of bodies without an entry in the moiety table (angles, lots, asteroids)
+const defaultMoietyOrb = 5.0
+
+// AspectSettings configures the orbs and aspect types used for aspect detection
+type AspectSettings struct {
+	Profile         string                        // orb profile (defaults to "standard")
+	AspectOrbs      map[domain.AspectType]float64 // base orb overrides per aspect type, 0 disables the aspect
+	PlanetOrbs      map[string]float64            // per-planet adjustments, or full planetary orbs for "moiety"
+	MinorAspects    bool                          // enables semisextiles, semisquares and sesquisquares
+	ExtendedAspects bool                          // enables the quintile, septile, novile and decile series
+	CustomAspects   []domain.CustomAspect         // aspects defined for this calculation only
+	PointOrbs       map[domain.PlanetType]float64 // maximum orb per sensitive point type, 0 disables its aspects
 }
 
 // NewAspectCalculator creates a new aspect calculator with default settings
 func NewAspectCalculator() *AspectCalculator {
-	return &AspectCalculator{
+	ac := &AspectCalculator{
+		definitions:    domain.GetAspectDefinitions(),
 		aspectOrbs:     getDefaultAspectOrbs(),
 		planetOrbs:     getDefaultPlanetOrbs(),
 		enabledAspects: getDefaultEnabledAspects(),
+		pointOrbs:      getDefaultPointOrbs(),
+		explicitOrbs:   make(map[domain.AspectType]bool),
+	}
+
+	// Custom aspects registered from configuration are enabled with their own orb
+	for _, def := range ac.definitions {
+		if _, exists := ac.aspectOrbs[def.Type]; !exists {
+			ac.aspectOrbs[def.Type] = def.BaseOrb
+			ac.enabledAspects[def.Type] = true
+		}
+	}
+
+	return ac
+}
+
+// NewAspectCalculatorWithSettings creates an aspect calculator from an orb profile and explicit overrides
+func NewAspectCalculatorWithSettings(settings AspectSettings) (*AspectCalculator, error) {
+	ac := NewAspectCalculator()
+
+	switch settings.Profile {
+	case "", OrbProfileStandard:
+	case OrbProfileTight:
+		ac.setAspectOrbs(getTightAspectOrbs())
+		ac.planetOrbs = getTightPlanetOrbs()
+	case OrbProfileWide:
+		ac.setAspectOrbs(getWideAspectOrbs())
+	case OrbProfileMoiety:
+		ac.planetOrbs = getMoietyPlanetOrbs()
+		ac.moiety = true
+	default:
+		return nil, fmt.Errorf("invalid orb profile: %s (supported: %v)", settings.Profile, GetOrbProfiles())
+	}
+
+	if settings.MinorAspects {
+		ac.EnableAspect(domain.AspectSemisextile, true)
+		ac.EnableAspect(domain.AspectSemisquare, true)
+		ac.EnableAspect(domain.AspectSesquisquare, true)
+	}
+
+	if settings.ExtendedAspects {
+		for _, aspectType := range getExtendedAspects() {
+			ac.EnableAspect(aspectType, true)
+		}
+	}
+
+	for _, custom := range settings.CustomAspects {
+		def, err := custom.ToDefinition()
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := ac.aspectOrbs[def.Type]; exists {
+			return nil, fmt.Errorf("aspect %s is already defined", def.Type)
+		}
+		ac.definitions = append(ac.definitions, def)
+		ac.SetAspectOrb(def.Type, def.BaseOrb)
+		ac.EnableAspect(def.Type, true)
+	}
+
+	for aspectType, orb := range settings.AspectOrbs {
+		if _, exists := ac.aspectOrbs[aspectType]; !exists {
+			return nil, fmt.Errorf("unknown aspect type in aspect_orbs: %s", aspectType)
+		}
+		if orb < 0 || orb > 15 {
+			return nil, fmt.Errorf("orb for %s must be between 0 and 15 degrees", aspectType)
+		}
+		ac.SetAspectOrb(aspectType, orb)
+		ac.explicitOrbs[aspectType] = true
+		if orb == 0 {
+			ac.EnableAspect(aspectType, false)
+		}
+	}
+
+	for pointType, orb := range settings.PointOrbs {
+		if _, exists := ac.pointOrbs[pointType]; !exists {
+			return nil, fmt.Errorf("unknown point type in point_orbs: %s (supported: %v)", pointType, GetPointTypes())
+		}
+		if orb < 0 || orb > 10 {
+			return nil, fmt.Errorf("orb for %s points must be between 0 and 10 degrees", pointType)
+		}
+		ac.pointOrbs[pointType] = orb
+	}
+
+	for planetName, orb := range settings.PlanetOrbs {
+		if !domain.IsKnownBodyName(planetName) {
+			return nil, fmt.Errorf("unknown planet in planet_orbs: %s", planetName)
+		}
+		if ac.moiety && (orb < 0 || orb > 30) {
+			return nil, fmt.Errorf("moiety orb for %s must be between 0 and 30 degrees", planetName)
+		}
+		if !ac.moiety && (orb < -5 || orb > 5) {
+			return nil, fmt.Errorf("orb adjustment for %s must be between -5 and 5 degrees", planetName)
+		}
+		ac.SetPlanetOrb(planetName, orb)
+	}
+
+	return ac, nil
+}
+
+// NewFixedOrbAspectCalculator creates an aspect calculator that allows the same orb for every
+// enabled aspect and planet, as used for progressed aspects. Sensitive points keep their own
+// orb when it is smaller.
+func NewFixedOrbAspectCalculator(orb float64) *AspectCalculator {
+	ac := NewAspectCalculator()
+	for aspectType := range ac.aspectOrbs {
+		ac.aspectOrbs[aspectType] = orb
+	}
+	ac.planetOrbs = map[string]float64{}
+	return ac
+}
+
+// LoadCustomAspects registers the custom aspects listed in a JSON file so that every
+// calculation can use them. It returns the number of aspects registered.
+func LoadCustomAspects(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read custom aspects file: %w", err)
+	}
+
+	var customAspects []domain.CustomAspect
+	if err := json.Unmarshal(content, &customAspects); err != nil {
+		return 0, fmt.Errorf("failed to parse custom aspects file: %w", err)
+	}
+
+	for _, custom := range customAspects {
+		def, err := custom.ToDefinition()
+		if err != nil {
+			return 0, err
+		}
+		if err := domain.RegisterAspectDefinition(def); err != nil {
+			return 0, err
+		}
 	}
+
+	return len(customAspects), nil
+}
+
+// GetPointTypes returns the sensitive point types with their own orb rules
+func GetPointTypes() []domain.PlanetType {
+	return []domain.PlanetType{domain.TypeAngle, domain.TypeHouseCusp, domain.TypeSensitivePoint, domain.TypeLot, domain.TypeLunar}
+}
+
+// GetOrbProfiles returns the supported orb profile names
+func GetOrbProfiles() []string {
+	return []string{OrbProfileStandard, OrbProfileTight, OrbProfileWide, OrbProfileMoiety}
 }
 
 // CalculateAspects calculates all aspects between a list of planets
@@ -42,20 +218,84 @@ func (ac *AspectCalculator) CalculateAspects(planets []domain.Planet) []domain.A
 	return aspects
 }
 
-// calculateAspectBetweenPlanets calculates the aspect between two planets
+// CalculateAspectsWithPoints calculates aspects between planets and between planets and
+// calculated points such as lots. Points are not aspected to each other since they are
+// derived from the same planets.
+func (ac *AspectCalculator) CalculateAspectsWithPoints(planets, points []domain.Planet) []domain.Aspect {
+	aspects := ac.CalculateAspects(planets)
+	return append(aspects, ac.calculateCrossAspects(planets, points)...)
+}
+
+// CalculateAspectsBetweenChartsWithPoints calculates aspects between the planets of two charts
+// and from the planets of each chart to the sensitive points (angles, lots) of the other.
+// Planet1 of each aspect always belongs to the first chart.
+func (ac *AspectCalculator) CalculateAspectsBetweenChartsWithPoints(
+	chart1Planets, chart1Points, chart2Planets, chart2Points []domain.Planet,
+) []domain.Aspect {
+	aspects := ac.CalculateAspectsBetweenCharts(chart1Planets, chart2Planets)
+	aspects = append(aspects, ac.CalculateAspectsBetweenCharts(chart1Planets, chart2Points)...)
+	return append(aspects, ac.CalculateAspectsBetweenCharts(chart1Points, chart2Planets)...)
+}
+
+// calculateAspectBetweenPlanets finds the closest enabled aspect between two planets
+// within the orb allowed for that pair. Sensitive points only take Ptolemaic aspects,
+// within the orb of their point type.
 func (ac *AspectCalculator) calculateAspectBetweenPlanets(planet1, planet2 domain.Planet) *domain.Aspect {
-	return domain.NewAspect(
-		planet1.Name,
-		planet2.Name,
-		planet1.Longitude,
-		planet2.Longitude,
-		planet1.Speed,
-		planet2.Speed,
-	)
+	var best *domain.Aspect
+
+	pointOrb, isPoint := ac.getPointOrb(planet1.Name, planet2.Name)
+
+	for _, def := range ac.definitions {
+		if !ac.enabledAspects[def.Type] {
+			continue
+		}
+		if isPoint && !isPtolemaicAspect(def.Type) {
+			continue
+		}
+
+		maxOrb := ac.GetDynamicOrb(planet1.Name, planet2.Name, def.Type)
+		if isPoint && pointOrb < maxOrb {
+			maxOrb = pointOrb
+		}
+
+		aspect := domain.NewAspectWithOrb(
+			planet1.Name,
+			planet2.Name,
+			planet1.Longitude,
+			planet2.Longitude,
+			planet1.Speed,
+			planet2.Speed,
+			def,
+			maxOrb,
+		)
+		if aspect != nil && (best == nil || aspect.Orb < best.Orb) {
+			best = aspect
+		}
+	}
+
+	return best
 }
 
-// CalculateAspectsBetweenCharts calculates aspects between planets from two different charts
+// CalculateAspectsBetweenCharts calculates aspects between planets from two different charts.
+// The second chart is held fixed (e.g. the natal chart under progressions), so an aspect applies
+// only while the body of the first chart moves toward the exact aspect. The charts are cast for
+// different moments, so no time to exactitude is given.
 func (ac *AspectCalculator) CalculateAspectsBetweenCharts(chart1Planets, chart2Planets []domain.Planet) []domain.Aspect {
+	fixed := make([]domain.Planet, len(chart2Planets))
+	for i, planet := range chart2Planets {
+		planet.Speed = 0
+		fixed[i] = planet
+	}
+
+	aspects := ac.calculateCrossAspects(chart1Planets, fixed)
+	for i := range aspects {
+		aspects[i].ClearTiming()
+	}
+	return aspects
+}
+
+// calculateCrossAspects calculates aspects from each body of the first set to each body of the second
+func (ac *AspectCalculator) calculateCrossAspects(chart1Planets, chart2Planets []domain.Planet) []domain.Aspect {
 	var aspects []domain.Aspect
 
 	for _, planet1 := range chart1Planets {
@@ -77,6 +317,14 @@ func (ac *AspectCalculator) GetDynamicOrb(planet1Name, planet2Name string, aspec
 		return 0 // Unknown aspect
 	}
 
+	// Moieties apply to the Ptolemaic aspects, the others and explicit aspect orbs keep their base orb
+	if ac.moiety {
+		if !isPtolemaicAspect(aspectType) || ac.explicitOrbs[aspectType] {
+			return baseOrb
+		}
+		return (ac.getMoietyOrb(planet1Name) + ac.getMoietyOrb(planet2Name)) / 2
+	}
+
 	// Get planet adjustments
 	adj1 := ac.planetOrbs[planet1Name]
 	adj2 := ac.planetOrbs[planet2Name]
@@ -85,11 +333,52 @@ func (ac *AspectCalculator) GetDynamicOrb(planet1Name, planet2Name string, aspec
 	return baseOrb + adj1 + adj2
 }
 
+// getPointOrb returns the smallest point orb of the two bodies and whether either is a sensitive point
+func (ac *AspectCalculator) getPointOrb(name1, name2 string) (float64, bool) {
+	orb, isPoint := 0.0, false
+	for _, name := range []string{name1, name2} {
+		pointOrb, exists := ac.pointOrbs[domain.GetPlanetType(name)]
+		if !exists {
+			continue
+		}
+		if !isPoint || pointOrb < orb {
+			orb = pointOrb
+		}
+		isPoint = true
+	}
+	return orb, isPoint
+}
+
+// getMoietyOrb returns the full orb of a planet in moiety mode
+func (ac *AspectCalculator) getMoietyOrb(planetName string) float64 {
+	if orb, exists := ac.planetOrbs[planetName]; exists {
+		return orb
+	}
+	return defaultMoietyOrb
+}
+
+// isPtolemaicAspect returns true for the five aspects described by Ptolemy
+func isPtolemaicAspect(aspectType domain.AspectType) bool {
+	switch aspectType {
+	case domain.AspectConjunction, domain.AspectSextile, domain.AspectSquare,
+		domain.AspectTrine, domain.AspectOpposition:
+		return true
+	}
+	return false
+}
+
 // SetAspectOrb sets the orb for a specific aspect
 func (ac *AspectCalculator) SetAspectOrb(aspectType domain.AspectType, orb float64) {
 	ac.aspectOrbs[aspectType] = orb
 }
 
+// setAspectOrbs overrides the base orbs of the given aspect types
+func (ac *AspectCalculator) setAspectOrbs(orbs map[domain.AspectType]float64) {
+	for aspectType, orb := range orbs {
+		ac.aspectOrbs[aspectType] = orb
+	}
+}
+
 // SetPlanetOrb sets the orb adjustment for a specific planet
 func (ac *AspectCalculator) SetPlanetOrb(planetName string, orb float64) {
 	ac.planetOrbs[planetName] = orb
@@ -229,6 +518,16 @@ func getDefaultAspectOrbs() map[domain.AspectType]float64 {
 		domain.AspectSemisextile:  1.0,
 		domain.AspectSemisquare:   1.0,
 		domain.AspectSesquisquare: 1.0,
+		domain.AspectQuintile:     2.0,
+		domain.AspectBiquintile:   2.0,
+		domain.AspectSeptile:      1.0,
+		domain.AspectBiseptile:    1.0,
+		domain.AspectTriseptile:   1.0,
+		domain.AspectNovile:       1.0,
+		domain.AspectBinovile:     1.0,
+		domain.AspectQuadnovile:   1.0,
+		domain.AspectDecile:       1.0,
+		domain.AspectTredecile:    1.0,
 	}
 }
 
@@ -250,6 +549,93 @@ func getDefaultPlanetOrbs() map[string]float64 {
 	}
 }
 
+// getTightAspectOrbs returns narrower orbs for the tight profile
+func getTightAspectOrbs() map[domain.AspectType]float64 {
+	return map[domain.AspectType]float64{
+		domain.AspectConjunction:  6.0,
+		domain.AspectOpposition:   6.0,
+		domain.AspectTrine:        5.0,
+		domain.AspectSquare:       5.0,
+		domain.AspectSextile:      3.0,
+		domain.AspectQuincunx:     1.5,
+		domain.AspectSemisextile:  1.0,
+		domain.AspectSemisquare:   1.0,
+		domain.AspectSesquisquare: 1.0,
+		domain.AspectQuintile:     1.0,
+		domain.AspectBiquintile:   1.0,
+		domain.AspectSeptile:      0.5,
+		domain.AspectBiseptile:    0.5,
+		domain.AspectTriseptile:   0.5,
+		domain.AspectNovile:       0.5,
+		domain.AspectBinovile:     0.5,
+		domain.AspectQuadnovile:   0.5,
+		domain.AspectDecile:       0.5,
+		domain.AspectTredecile:    0.5,
+	}
+}
+
+// getTightPlanetOrbs returns the orb adjustments of the tight profile, where only the luminaries get extra orb
+func getTightPlanetOrbs() map[string]float64 {
+	return map[string]float64{
+		"Sun":  1.0,
+		"Moon": 1.0,
+	}
+}
+
+// getWideAspectOrbs returns wider orbs for the wide profile
+func getWideAspectOrbs() map[domain.AspectType]float64 {
+	return map[domain.AspectType]float64{
+		domain.AspectConjunction:  10.0,
+		domain.AspectOpposition:   10.0,
+		domain.AspectTrine:        8.0,
+		domain.AspectSquare:       8.0,
+		domain.AspectSextile:      6.0,
+		domain.AspectQuincunx:     3.0,
+		domain.AspectSemisextile:  2.0,
+		domain.AspectSemisquare:   2.0,
+		domain.AspectSesquisquare: 2.0,
+		domain.AspectQuintile:     3.0,
+		domain.AspectBiquintile:   3.0,
+		domain.AspectSeptile:      1.5,
+		domain.AspectBiseptile:    1.5,
+		domain.AspectTriseptile:   1.5,
+		domain.AspectNovile:       1.5,
+		domain.AspectBinovile:     1.5,
+		domain.AspectQuadnovile:   1.5,
+		domain.AspectDecile:       1.5,
+		domain.AspectTredecile:    1.5,
+	}
+}
+
+// getMoietyPlanetOrbs returns the planetary orbs used by the moiety profile, as given by
+// William Lilly in Christian Astrology (1647); the outer planets are modern additions.
+// The moiety of a planet is half its orb.
+func getMoietyPlanetOrbs() map[string]float64 {
+	return map[string]float64{
+		"Sun":     15.0,
+		"Moon":    12.0,
+		"Mercury": 7.0,
+		"Venus":   7.0,
+		"Mars":    7.5,
+		"Jupiter": 12.0,
+		"Saturn":  10.0,
+		"Uranus":  5.0,
+		"Neptune": 5.0,
+		"Pluto":   5.0,
+	}
+}
+
+// getDefaultPointOrbs returns the maximum orbs of aspects to sensitive points
+func getDefaultPointOrbs() map[domain.PlanetType]float64 {
+	return map[domain.PlanetType]float64{
+		domain.TypeAngle:          5.0,
+		domain.TypeHouseCusp:      2.0,
+		domain.TypeSensitivePoint: 2.0,
+		domain.TypeLot:            3.0,
+		domain.TypeLunar:          3.0, // Nodes and Lilith
+	}
+}
+
 // getDefaultEnabledAspects returns which aspects are enabled by default
 func getDefaultEnabledAspects() map[domain.AspectType]bool {
 	return map[domain.AspectType]bool{
@@ -262,89 +648,332 @@ func getDefaultEnabledAspects() map[domain.AspectType]bool {
 		domain.AspectSemisextile:  false, // Minor aspects disabled by default
 		domain.AspectSemisquare:   false,
 		domain.AspectSesquisquare: false,
+		domain.AspectQuintile:     false, // Harmonic series are enabled with ExtendedAspects
+		domain.AspectBiquintile:   false,
+		domain.AspectSeptile:      false,
+		domain.AspectBiseptile:    false,
+		domain.AspectTriseptile:   false,
+		domain.AspectNovile:       false,
+		domain.AspectBinovile:     false,
+		domain.AspectQuadnovile:   false,
+		domain.AspectDecile:       false,
+		domain.AspectTredecile:    false,
+	}
+}
+
+// getExtendedAspects returns the quintile, septile, novile and decile series
+func getExtendedAspects() []domain.AspectType {
+	return []domain.AspectType{
+		domain.AspectQuintile, domain.AspectBiquintile,
+		domain.AspectSeptile, domain.AspectBiseptile, domain.AspectTriseptile,
+		domain.AspectNovile, domain.AspectBinovile, domain.AspectQuadnovile,
+		domain.AspectDecile, domain.AspectTredecile,
 	}
 }
 
-// CalculateAspectPatterns identifies special aspect patterns
-func (ac *AspectCalculator) CalculateAspectPatterns(aspects []domain.Aspect, planets []domain.Planet) []AspectPattern {
-	var patterns []AspectPattern
+// CalculateAspectPatterns identifies aspect patterns by treating planets as the nodes of a graph
+// whose edges are the aspects between them. Stelliums are grouped by sign and by house.
+func (ac *AspectCalculator) CalculateAspectPatterns(aspects []domain.Aspect, planets []domain.Planet) []domain.AspectPattern {
+	graph := newAspectGraph(aspects, planets)
+	var patterns []domain.AspectPattern
 
-	// Grand Trine pattern
-	grandTrines := ac.findGrandTrines(aspects, planets)
+	grandTrines := graph.findGrandTrines()
 	for _, gt := range grandTrines {
-		patterns = append(patterns, AspectPattern{
-			Type:    "Grand Trine",
-			Planets: gt,
-			Element: domain.GetElementForSign(domain.GetZodiacSign(planets[0].Longitude)), // Element of first planet
-		})
+		patterns = append(patterns, graph.newPattern(domain.PatternGrandTrine, gt, -1))
+	}
+
+	grandCrosses := graph.findGrandCrosses()
+	for _, gc := range grandCrosses {
+		patterns = append(patterns, graph.newPattern(domain.PatternGrandCross, gc, -1))
+	}
+
+	for _, ts := range graph.findTSquares(grandCrosses) {
+		patterns = append(patterns, graph.newPattern(domain.PatternTSquare, ts, 2))
+	}
+
+	for _, yod := range graph.findYods() {
+		patterns = append(patterns, graph.newPattern(domain.PatternYod, yod, 2))
+	}
+
+	for _, kite := range graph.findKites(grandTrines) {
+		patterns = append(patterns, graph.newPattern(domain.PatternKite, kite, 0))
 	}
 
-	// T-Square pattern
-	tSquares := ac.findTSquares(aspects, planets)
-	for _, ts := range tSquares {
-		patterns = append(patterns, AspectPattern{
-			Type:    "T-Square",
-			Planets: ts,
-			Element: "", // T-Squares don't have a single element
-		})
+	for _, mr := range graph.findMysticRectangles() {
+		patterns = append(patterns, graph.newPattern(domain.PatternMysticRectangle, mr, -1))
 	}
 
-	// Stellium pattern (3+ planets in same sign)
-	stelliums := ac.findStelliums(planets)
-	for _, st := range stelliums {
-		patterns = append(patterns, AspectPattern{
-			Type:    "Stellium",
-			Planets: st.Planets,
-			Element: domain.GetElementForSign(st.Sign),
-		})
+	for _, gs := range graph.findGrandSextiles(grandTrines) {
+		patterns = append(patterns, graph.newPattern(domain.PatternGrandSextile, gs, -1))
 	}
 
+	patterns = append(patterns, ac.findStelliums(planets)...)
+
 	return patterns
 }
 
-// AspectPattern represents a special aspect pattern
-type AspectPattern struct {
-	Type    string   `json:"type"`
-	Planets []string `json:"planets"`
-	Element string   `json:"element,omitempty"`
+// aspectGraph stores the aspect between every pair of planets
+type aspectGraph struct {
+	planets []domain.Planet
+	index   map[string]int
+	edges   map[[2]int]domain.AspectType
 }
 
-// Stellium represents a stellium pattern
-type Stellium struct {
-	Sign    string   `json:"sign"`
-	Planets []string `json:"planets"`
+// newAspectGraph builds the aspect graph of the planets. Aspects to points that are not
+// planets (e.g. lots) and the axis between the lunar nodes are left out.
+func newAspectGraph(aspects []domain.Aspect, planets []domain.Planet) *aspectGraph {
+	graph := &aspectGraph{
+		planets: planets,
+		index:   make(map[string]int),
+		edges:   make(map[[2]int]domain.AspectType),
+	}
+	for i, planet := range planets {
+		graph.index[planet.Name] = i
+	}
+
+	for _, aspect := range aspects {
+		i, ok1 := graph.index[aspect.Planet1]
+		j, ok2 := graph.index[aspect.Planet2]
+		if !ok1 || !ok2 || isNodePair(aspect.Planet1, aspect.Planet2) {
+			continue
+		}
+		graph.edges[[2]int{i, j}] = aspect.Type
+		graph.edges[[2]int{j, i}] = aspect.Type
+	}
+
+	return graph
 }
 
-// findGrandTrines finds Grand Trine patterns
-func (ac *AspectCalculator) findGrandTrines(aspects []domain.Aspect, planets []domain.Planet) [][]string {
-	// Implementation would find sets of three planets all forming trines with each other
-	// This is a complex algorithm that would require more detailed implementation
-	return [][]string{} // Placeholder
+// has returns true if planets i and j form the given aspect
+func (g *aspectGraph) has(i, j int, aspectType domain.AspectType) bool {
+	return g.edges[[2]int{i, j}] == aspectType
 }
 
-// findTSquares finds T-Square patterns
-func (ac *AspectCalculator) findTSquares(aspects []domain.Aspect, planets []domain.Planet) [][]string {
-	// Implementation would find patterns where two planets oppose each other and both square a third
-	// This is a complex algorithm that would require more detailed implementation
-	return [][]string{} // Placeholder
+// newPattern creates a pattern from planet indexes; apex is the position of the focal planet or -1
+func (g *aspectGraph) newPattern(patternType domain.PatternType, members []int, apex int) domain.AspectPattern {
+	var planets []domain.Planet
+	var names []string
+	for _, i := range members {
+		planets = append(planets, g.planets[i])
+		names = append(names, g.planets[i].Name)
+	}
+
+	pattern := domain.AspectPattern{
+		Type:     patternType,
+		Planets:  names,
+		Element:  domain.CommonElement(planets),
+		Modality: domain.CommonModality(planets),
+	}
+	if apex >= 0 {
+		pattern.Apex = names[apex]
+	}
+	return pattern
 }
 
-// findStelliums finds Stellium patterns (3+ planets in same sign)
-func (ac *AspectCalculator) findStelliums(planets []domain.Planet) []Stellium {
-	signGroups := make(map[string][]string)
+// findGrandTrines finds three planets in mutual trine
+func (g *aspectGraph) findGrandTrines() [][]int {
+	var result [][]int
+	n := len(g.planets)
+	for a := 0; a < n; a++ {
+		for b := a + 1; b < n; b++ {
+			if !g.has(a, b, domain.AspectTrine) {
+				continue
+			}
+			for c := b + 1; c < n; c++ {
+				if g.has(a, c, domain.AspectTrine) && g.has(b, c, domain.AspectTrine) {
+					result = append(result, []int{a, b, c})
+				}
+			}
+		}
+	}
+	return result
+}
 
-	// Group planets by sign
+// findGrandCrosses finds two oppositions whose four planets square each other
+func (g *aspectGraph) findGrandCrosses() [][]int {
+	var result [][]int
+	oppositions := g.pairs(domain.AspectOpposition)
+	for x := 0; x < len(oppositions); x++ {
+		for y := x + 1; y < len(oppositions); y++ {
+			a, b := oppositions[x][0], oppositions[x][1]
+			c, d := oppositions[y][0], oppositions[y][1]
+			if g.has(a, c, domain.AspectSquare) && g.has(a, d, domain.AspectSquare) &&
+				g.has(b, c, domain.AspectSquare) && g.has(b, d, domain.AspectSquare) {
+				result = append(result, []int{a, c, b, d})
+			}
+		}
+	}
+	return result
+}
+
+// findTSquares finds oppositions squared by a third planet (the apex).
+// T-Squares that are part of a Grand Cross are not reported separately.
+func (g *aspectGraph) findTSquares(grandCrosses [][]int) [][]int {
+	var result [][]int
+	for _, opposition := range g.pairs(domain.AspectOpposition) {
+		a, b := opposition[0], opposition[1]
+		for c := range g.planets {
+			if c == a || c == b || !g.has(a, c, domain.AspectSquare) || !g.has(b, c, domain.AspectSquare) {
+				continue
+			}
+			tSquare := []int{a, b, c}
+			if !containedInAny(tSquare, grandCrosses) {
+				result = append(result, tSquare)
+			}
+		}
+	}
+	return result
+}
+
+// findYods finds a sextile whose two planets are both quincunx a third planet (the apex)
+func (g *aspectGraph) findYods() [][]int {
+	var result [][]int
+	for _, sextile := range g.pairs(domain.AspectSextile) {
+		a, b := sextile[0], sextile[1]
+		for c := range g.planets {
+			if c != a && c != b && g.has(a, c, domain.AspectQuincunx) && g.has(b, c, domain.AspectQuincunx) {
+				result = append(result, []int{a, b, c})
+			}
+		}
+	}
+	return result
+}
+
+// findKites finds a Grand Trine with a fourth planet opposite one corner (the apex)
+// and sextile the other two
+func (g *aspectGraph) findKites(grandTrines [][]int) [][]int {
+	var result [][]int
+	for _, trine := range grandTrines {
+		for corner := 0; corner < 3; corner++ {
+			apex := trine[corner]
+			wing1, wing2 := trine[(corner+1)%3], trine[(corner+2)%3]
+			for d := range g.planets {
+				if g.has(apex, d, domain.AspectOpposition) &&
+					g.has(wing1, d, domain.AspectSextile) && g.has(wing2, d, domain.AspectSextile) {
+					result = append(result, []int{apex, wing1, wing2, d})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// findMysticRectangles finds two oppositions joined by two sextiles and two trines
+func (g *aspectGraph) findMysticRectangles() [][]int {
+	var result [][]int
+	oppositions := g.pairs(domain.AspectOpposition)
+	for x := 0; x < len(oppositions); x++ {
+		for y := x + 1; y < len(oppositions); y++ {
+			a, b := oppositions[x][0], oppositions[x][1]
+			for _, other := range [][2]int{oppositions[y], {oppositions[y][1], oppositions[y][0]}} {
+				c, d := other[0], other[1]
+				if g.has(a, c, domain.AspectSextile) && g.has(b, d, domain.AspectSextile) &&
+					g.has(a, d, domain.AspectTrine) && g.has(b, c, domain.AspectTrine) {
+					result = append(result, []int{a, c, b, d})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// findGrandSextiles finds two Grand Trines whose corners are pairwise opposite
+func (g *aspectGraph) findGrandSextiles(grandTrines [][]int) [][]int {
+	var result [][]int
+	for x := 0; x < len(grandTrines); x++ {
+		for y := x + 1; y < len(grandTrines); y++ {
+			matched := 0
+			for _, a := range grandTrines[x] {
+				for _, b := range grandTrines[y] {
+					if g.has(a, b, domain.AspectOpposition) {
+						matched++
+						break
+					}
+				}
+			}
+			if matched == 3 {
+				result = append(result, append(append([]int{}, grandTrines[x]...), grandTrines[y]...))
+			}
+		}
+	}
+	return result
+}
+
+// pairs returns every planet pair (i < j) forming the given aspect
+func (g *aspectGraph) pairs(aspectType domain.AspectType) [][2]int {
+	var result [][2]int
+	for i := range g.planets {
+		for j := i + 1; j < len(g.planets); j++ {
+			if g.has(i, j, aspectType) {
+				result = append(result, [2]int{i, j})
+			}
+		}
+	}
+	return result
+}
+
+// containedInAny returns true if every member is part of one of the groups
+func containedInAny(members []int, groups [][]int) bool {
+	for _, group := range groups {
+		found := 0
+		for _, m := range members {
+			for _, g := range group {
+				if m == g {
+					found++
+					break
+				}
+			}
+		}
+		if found == len(members) {
+			return true
+		}
+	}
+	return false
+}
+
+// findStelliums finds three or more planets in the same sign or in the same house
+func (ac *AspectCalculator) findStelliums(planets []domain.Planet) []domain.AspectPattern {
+	bySign := make(map[string][]domain.Planet)
+	byHouse := make(map[int][]domain.Planet)
 	for _, planet := range planets {
-		sign := planet.Sign
-		signGroups[sign] = append(signGroups[sign], planet.Name)
+		bySign[planet.Sign] = append(bySign[planet.Sign], planet)
+		if planet.House > 0 {
+			byHouse[planet.House] = append(byHouse[planet.House], planet)
+		}
+	}
+
+	names := func(group []domain.Planet) []string {
+		var result []string
+		for _, planet := range group {
+			result = append(result, planet.Name)
+		}
+		return result
+	}
+
+	var stelliums []domain.AspectPattern
+
+	// Iterate in zodiac and house order so the output is stable
+	for i := 0; i < 12; i++ {
+		sign := domain.GetZodiacSign(float64(i * 30))
+		if group := bySign[sign]; len(group) >= 3 {
+			stelliums = append(stelliums, domain.AspectPattern{
+				Type:     domain.PatternStelliumBySign,
+				Planets:  names(group),
+				Element:  domain.GetElementForSign(sign),
+				Modality: domain.GetModalityForSign(sign),
+				Sign:     sign,
+			})
+		}
 	}
 
-	var stelliums []Stellium
-	for sign, planetNames := range signGroups {
-		if len(planetNames) >= 3 {
-			stelliums = append(stelliums, Stellium{
-				Sign:    sign,
-				Planets: planetNames,
+	for house := 1; house <= 12; house++ {
+		if group := byHouse[house]; len(group) >= 3 {
+			stelliums = append(stelliums, domain.AspectPattern{
+				Type:     domain.PatternStelliumByHouse,
+				Planets:  names(group),
+				Element:  domain.CommonElement(group),
+				Modality: domain.CommonModality(group),
+				House:    house,
 			})
 		}
 	}
@@ -0,0 +1,290 @@
+package astro
+
+import (
+	"reflect"
+	"testing"
+
+	"astroeph-api/internal/domain"
+)
+
+// testPlanet creates a planet in a sign and house with the sign's element and modality
+func testPlanet(name, sign string, house int) domain.Planet {
+	return domain.Planet{
+		Name:     name,
+		Sign:     sign,
+		House:    house,
+		Element:  domain.GetElementForSign(sign),
+		Modality: domain.GetModalityForSign(sign),
+	}
+}
+
+// testAspect creates an aspect between two planets
+func testAspect(planet1, planet2 string, aspectType domain.AspectType) domain.Aspect {
+	return domain.Aspect{Planet1: planet1, Planet2: planet2, Type: aspectType}
+}
+
+func TestCalculateAspectPatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		planets []domain.Planet
+		aspects []domain.Aspect
+		want    []domain.AspectPattern
+	}{
+		{
+			name: "grand trine in fire",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Leo", 5), testPlanet("Mars", "Sagittarius", 9),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Moon", domain.AspectTrine),
+				testAspect("Sun", "Mars", domain.AspectTrine),
+				testAspect("Moon", "Mars", domain.AspectTrine),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternGrandTrine, Planets: []string{"Sun", "Moon", "Mars"}, Element: "fire"},
+			},
+		},
+		{
+			name: "cardinal T-square",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Libra", 7), testPlanet("Mars", "Cancer", 4),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Moon", domain.AspectOpposition),
+				testAspect("Sun", "Mars", domain.AspectSquare),
+				testAspect("Moon", "Mars", domain.AspectSquare),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternTSquare, Planets: []string{"Sun", "Moon", "Mars"}, Apex: "Mars", Modality: "cardinal"},
+			},
+		},
+		{
+			name: "grand cross hides its T-squares",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Libra", 7),
+				testPlanet("Mars", "Cancer", 4), testPlanet("Venus", "Capricorn", 10),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Moon", domain.AspectOpposition),
+				testAspect("Mars", "Venus", domain.AspectOpposition),
+				testAspect("Sun", "Mars", domain.AspectSquare),
+				testAspect("Sun", "Venus", domain.AspectSquare),
+				testAspect("Moon", "Mars", domain.AspectSquare),
+				testAspect("Moon", "Venus", domain.AspectSquare),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternGrandCross, Planets: []string{"Sun", "Mars", "Moon", "Venus"}, Modality: "cardinal"},
+			},
+		},
+		{
+			name: "yod",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Gemini", 3), testPlanet("Mars", "Scorpio", 8),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Moon", domain.AspectSextile),
+				testAspect("Sun", "Mars", domain.AspectQuincunx),
+				testAspect("Moon", "Mars", domain.AspectQuincunx),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternYod, Planets: []string{"Sun", "Moon", "Mars"}, Apex: "Mars"},
+			},
+		},
+		{
+			name: "kite on a grand trine",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Leo", 5),
+				testPlanet("Mars", "Sagittarius", 9), testPlanet("Venus", "Libra", 7),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Moon", domain.AspectTrine),
+				testAspect("Sun", "Mars", domain.AspectTrine),
+				testAspect("Moon", "Mars", domain.AspectTrine),
+				testAspect("Sun", "Venus", domain.AspectOpposition),
+				testAspect("Moon", "Venus", domain.AspectSextile),
+				testAspect("Mars", "Venus", domain.AspectSextile),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternGrandTrine, Planets: []string{"Sun", "Moon", "Mars"}, Element: "fire"},
+				{Type: domain.PatternKite, Planets: []string{"Sun", "Moon", "Mars", "Venus"}, Apex: "Sun"},
+			},
+		},
+		{
+			name: "mystic rectangle",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Libra", 7),
+				testPlanet("Mars", "Gemini", 3), testPlanet("Venus", "Sagittarius", 9),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Moon", domain.AspectOpposition),
+				testAspect("Mars", "Venus", domain.AspectOpposition),
+				testAspect("Sun", "Mars", domain.AspectSextile),
+				testAspect("Moon", "Venus", domain.AspectSextile),
+				testAspect("Sun", "Venus", domain.AspectTrine),
+				testAspect("Moon", "Mars", domain.AspectTrine),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternMysticRectangle, Planets: []string{"Sun", "Mars", "Moon", "Venus"}},
+			},
+		},
+		{
+			name: "grand sextile",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Aries", 1), testPlanet("Moon", "Gemini", 3), testPlanet("Mercury", "Leo", 5),
+				testPlanet("Venus", "Libra", 7), testPlanet("Mars", "Sagittarius", 9), testPlanet("Jupiter", "Aquarius", 11),
+			},
+			aspects: []domain.Aspect{
+				testAspect("Sun", "Mercury", domain.AspectTrine),
+				testAspect("Sun", "Mars", domain.AspectTrine),
+				testAspect("Mercury", "Mars", domain.AspectTrine),
+				testAspect("Moon", "Venus", domain.AspectTrine),
+				testAspect("Moon", "Jupiter", domain.AspectTrine),
+				testAspect("Venus", "Jupiter", domain.AspectTrine),
+				testAspect("Sun", "Venus", domain.AspectOpposition),
+				testAspect("Moon", "Mars", domain.AspectOpposition),
+				testAspect("Mercury", "Jupiter", domain.AspectOpposition),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternGrandTrine, Planets: []string{"Sun", "Mercury", "Mars"}, Element: "fire"},
+				{Type: domain.PatternGrandTrine, Planets: []string{"Moon", "Venus", "Jupiter"}, Element: "air"},
+				{Type: domain.PatternGrandSextile, Planets: []string{"Sun", "Mercury", "Mars", "Moon", "Venus", "Jupiter"}},
+			},
+		},
+		{
+			name: "lunar nodes do not form a T-square",
+			planets: []domain.Planet{
+				testPlanet("North Node", "Aries", 1), testPlanet("South Node", "Libra", 7), testPlanet("Mars", "Cancer", 4),
+			},
+			aspects: []domain.Aspect{
+				testAspect("North Node", "South Node", domain.AspectOpposition),
+				testAspect("North Node", "Mars", domain.AspectSquare),
+				testAspect("South Node", "Mars", domain.AspectSquare),
+			},
+			want: nil,
+		},
+		{
+			name: "stelliums by sign and house",
+			planets: []domain.Planet{
+				testPlanet("Sun", "Taurus", 10), testPlanet("Mercury", "Taurus", 10),
+				testPlanet("Venus", "Taurus", 11), testPlanet("Mars", "Gemini", 10),
+			},
+			want: []domain.AspectPattern{
+				{Type: domain.PatternStelliumBySign, Planets: []string{"Sun", "Mercury", "Venus"},
+					Element: "earth", Modality: "fixed", Sign: "Taurus"},
+				{Type: domain.PatternStelliumByHouse, Planets: []string{"Sun", "Mercury", "Mars"}, House: 10},
+			},
+		},
+	}
+
+	ac := NewAspectCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ac.CalculateAspectPatterns(tt.aspects, tt.planets)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CalculateAspectPatterns() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDynamicOrb(t *testing.T) {
+	moiety := AspectSettings{Profile: OrbProfileMoiety}
+
+	tests := []struct {
+		name       string
+		settings   AspectSettings
+		planet1    string
+		planet2    string
+		aspectType domain.AspectType
+		want       float64
+	}{
+		{"standard adds both adjustments", AspectSettings{}, "Sun", "Moon", domain.AspectConjunction, 10},
+		{"standard without adjustments", AspectSettings{}, "Mars", "Venus", domain.AspectSquare, 6},
+		{"standard with aspect override", AspectSettings{AspectOrbs: map[domain.AspectType]float64{domain.AspectSquare: 5}},
+			"Mars", "Venus", domain.AspectSquare, 5},
+		{"moiety of the luminaries", moiety, "Sun", "Moon", domain.AspectConjunction, 13.5},
+		{"moiety is the same for every Ptolemaic aspect", moiety, "Sun", "Moon", domain.AspectSextile, 13.5},
+		{"moiety of Saturn and Mars", moiety, "Saturn", "Mars", domain.AspectSquare, 8.75},
+		{"moiety of a body without a planetary orb", moiety, "Sun", "Ascendant", domain.AspectTrine, 10},
+		{"moiety with planet orb override", AspectSettings{Profile: OrbProfileMoiety, PlanetOrbs: map[string]float64{"Mars": 9}},
+			"Mars", "Venus", domain.AspectOpposition, 8},
+		{"moiety with an angle orb", AspectSettings{Profile: OrbProfileMoiety, PlanetOrbs: map[string]float64{"Ascendant": 8}},
+			"Sun", "Ascendant", domain.AspectTrine, 11.5},
+		{"non-Ptolemaic aspect keeps its base orb", moiety, "Sun", "Moon", domain.AspectQuincunx, 2},
+		{"explicit aspect orb replaces the moieties",
+			AspectSettings{Profile: OrbProfileMoiety, AspectOrbs: map[domain.AspectType]float64{domain.AspectTrine: 6}},
+			"Sun", "Moon", domain.AspectTrine, 6},
+		{"explicit aspect orb leaves the other moieties", AspectSettings{Profile: OrbProfileMoiety, AspectOrbs: map[domain.AspectType]float64{domain.AspectTrine: 6}},
+			"Sun", "Moon", domain.AspectSquare, 13.5},
+		{"unknown aspect", AspectSettings{}, "Sun", "Moon", domain.AspectType("undecile"), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ac, err := NewAspectCalculatorWithSettings(tt.settings)
+			if err != nil {
+				t.Fatalf("NewAspectCalculatorWithSettings() error = %v", err)
+			}
+			if got := ac.GetDynamicOrb(tt.planet1, tt.planet2, tt.aspectType); got != tt.want {
+				t.Errorf("GetDynamicOrb(%q, %q, %s) = %v, want %v", tt.planet1, tt.planet2, tt.aspectType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAspectCalculatorWithSettingsErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings AspectSettings
+	}{
+		{"unknown profile", AspectSettings{Profile: "loose"}},
+		{"moiety orb above 30", AspectSettings{Profile: OrbProfileMoiety, PlanetOrbs: map[string]float64{"Sun": 31}}},
+		{"negative moiety orb", AspectSettings{Profile: OrbProfileMoiety, PlanetOrbs: map[string]float64{"Sun": -1}}},
+		{"standard adjustment above 5", AspectSettings{PlanetOrbs: map[string]float64{"Sun": 6}}},
+		{"unknown aspect override", AspectSettings{AspectOrbs: map[domain.AspectType]float64{"undecile": 1}}},
+		{"unknown planet in planet orbs", AspectSettings{PlanetOrbs: map[string]float64{"Marss": 1}}},
+		{"unknown planet in moiety orbs", AspectSettings{Profile: OrbProfileMoiety, PlanetOrbs: map[string]float64{"Sun ": 10}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewAspectCalculatorWithSettings(tt.settings); err == nil {
+				t.Error("NewAspectCalculatorWithSettings() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestCalculateAspectsBetweenChartsHoldsSecondChartFixed(t *testing.T) {
+	tests := []struct {
+		name         string
+		progressed   domain.Planet
+		natal        domain.Planet
+		wantApplying bool
+	}{
+		// The natal Moon's own motion would otherwise widen the square
+		{"progressed Sun closing the square", domain.Planet{Name: "Sun", Longitude: 8, Speed: 1},
+			domain.Planet{Name: "Moon", Longitude: 100, Speed: 13}, true},
+		// The natal Moon's own motion would otherwise close the square
+		{"progressed Sun leaving the square", domain.Planet{Name: "Sun", Longitude: 12, Speed: 1},
+			domain.Planet{Name: "Moon", Longitude: 100, Speed: 13}, false},
+		{"progressed body without motion", domain.Planet{Name: "Sun", Longitude: 8},
+			domain.Planet{Name: "Moon", Longitude: 100, Speed: 13}, false},
+	}
+
+	ac := NewAspectCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aspects := ac.CalculateAspectsBetweenCharts([]domain.Planet{tt.progressed}, []domain.Planet{tt.natal})
+			if len(aspects) != 1 || aspects[0].Type != domain.AspectSquare {
+				t.Fatalf("CalculateAspectsBetweenCharts() = %+v, want one square", aspects)
+			}
+			if aspects[0].IsApplying != tt.wantApplying {
+				t.Errorf("IsApplying = %v, want %v", aspects[0].IsApplying, tt.wantApplying)
+			}
+			if aspects[0].DaysToExact != nil || aspects[0].ExactDate != nil {
+				t.Errorf("aspect between charts has timing: %+v", aspects[0])
+			}
+		})
+	}
+}
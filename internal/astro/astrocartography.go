@@ -0,0 +1,470 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"astroeph-api/pkg/geojson"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Astrocartography defaults and limits in degrees and kilometers
+const (
+	DefaultACGLatitudeStep  = 1.0    // latitude sampling step of the lines
+	DefaultACGMaxDistanceKm = 1000.0 // radius of the nearest lines query
+	DefaultLocalSpaceOrb    = 1.0    // bearing orb of the cities along local space lines
+	acgMaxLatitude          = 89.0   // lines stop short of the poles
+	acgMaxRefinement        = 8      // maximum bisections of a step where a line turns fast
+	acgDistanceSamples      = 10     // points checked along each step of a line for distances
+	acgParanStep            = 0.1    // latitude scan step of the parans, fine enough to separate close crossings
+	acgParanPrecision       = 0.01   // latitude precision of the parans
+	localSpaceStep          = 2.0    // arc between the points of a local space line
+	localSpaceMaxCities     = 10     // cities listed along each local space line
+)
+
+// Population limits of the cities checked along local space lines
+const (
+	DefaultLocalSpaceMinPopulation = 1000000 // smallest city listed by default
+	MinLocalSpacePopulation        = 100000  // smallest city that can be requested
+	LocalSpaceCityLimit            = 5000    // largest cities checked on each request
+)
+
+// AstrocartographyCalculator computes the places where each planet is angular at a given moment
+type AstrocartographyCalculator struct {
+	ephemeris *Ephemeris
+}
+
+// NewAstrocartographyCalculator creates a new astrocartography calculator
+func NewAstrocartographyCalculator(ephemeris *Ephemeris) *AstrocartographyCalculator {
+	return &AstrocartographyCalculator{
+		ephemeris: ephemeris,
+	}
+}
+
+// ParseACGAngles converts angle names ("MC", "IC", "ASC", "DSC") to angles; no names means all four
+func ParseACGAngles(names []string) ([]domain.ACGAngle, error) {
+	if len(names) == 0 {
+		return domain.GetACGAngles(), nil
+	}
+
+	var angles []domain.ACGAngle
+	for _, name := range names {
+		angle := domain.ACGAngle(strings.ToUpper(strings.TrimSpace(name)))
+		switch angle {
+		case domain.ACGMidheaven, domain.ACGIC, domain.ACGAscendant, domain.ACGDescendant:
+			angles = append(angles, angle)
+		default:
+			return nil, fmt.Errorf("unknown astrocartography angle: %s", name)
+		}
+	}
+	return angles, nil
+}
+
+// CalculateLines computes the MC, IC, ASC and DSC lines of the planets from their right
+// ascension and declination. MC and IC lines are meridians; ASC and DSC lines are sampled
+// by latitude and end where the planet becomes circumpolar, meeting the MC or IC line.
+func (ac *AstrocartographyCalculator) CalculateLines(
+	timeInfo *domain.TimeInfo,
+	planets []domain.Planet,
+	angles []domain.ACGAngle,
+	latitudeStep float64,
+) []domain.ACGLine {
+
+	// Greenwich sidereal time in degrees
+	greenwichSiderealTime := ac.ephemeris.GetARMC(ac.ephemeris.GetJulianDay(timeInfo), 0)
+
+	var lines []domain.ACGLine
+	for _, planet := range planets {
+		for _, angle := range angles {
+			longitudeAt := ac.getLineFunction(planet.RightAscension, planet.Declination, greenwichSiderealTime, angle)
+			limit := getLatitudeLimit(planet, angle)
+
+			lines = append(lines, domain.ACGLine{
+				Planet:   planet.Name,
+				Angle:    angle,
+				Segments: ac.sampleLine(longitudeAt, -limit, limit, latitudeStep),
+			})
+		}
+	}
+
+	return lines
+}
+
+// getLatitudeLimit returns the highest latitude of a line; beyond it the planet never rises or sets
+func getLatitudeLimit(planet domain.Planet, angle domain.ACGAngle) float64 {
+	if angle == domain.ACGAscendant || angle == domain.ACGDescendant {
+		return math.Min(acgMaxLatitude, 90-math.Abs(planet.Declination))
+	}
+	return acgMaxLatitude
+}
+
+// getLineFunction returns the longitude of a line as a function of latitude
+func (ac *AstrocartographyCalculator) getLineFunction(
+	rightAscension, declination, greenwichSiderealTime float64,
+	angle domain.ACGAngle,
+) func(latitude float64) float64 {
+
+	return func(latitude float64) float64 {
+		hourAngle := 0.0
+		switch angle {
+		case domain.ACGIC:
+			hourAngle = 180
+		case domain.ACGAscendant, domain.ACGDescendant:
+			// Semi-diurnal arc: cos H = -tan(latitude) tan(declination)
+			cosine := -math.Tan(latitude*math.Pi/180) * math.Tan(declination*math.Pi/180)
+			semiArc := math.Acos(math.Max(-1, math.Min(1, cosine))) * 180 / math.Pi
+			hourAngle = semiArc
+			if angle == domain.ACGAscendant {
+				hourAngle = -semiArc
+			}
+		}
+		// Local sidereal time = right ascension + hour angle
+		return normalizeLongitude(rightAscension + hourAngle - greenwichSiderealTime)
+	}
+}
+
+// sampleLine samples a line between two latitudes, refining the steps where it turns fast
+// near its polar limit and splitting it where it crosses the antimeridian
+func (ac *AstrocartographyCalculator) sampleLine(longitudeAt func(float64) float64, from, to, step float64) [][]domain.GeoPoint {
+	if from >= to {
+		return nil
+	}
+
+	var points []domain.GeoPoint
+	var refine func(latitude1, latitude2 float64, depth int)
+	refine = func(latitude1, latitude2 float64, depth int) {
+		longitude1, longitude2 := longitudeAt(latitude1), longitudeAt(latitude2)
+		if depth < acgMaxRefinement && math.Abs(normalizeLongitude(longitude2-longitude1)) > 2*step {
+			middle := (latitude1 + latitude2) / 2
+			refine(latitude1, middle, depth+1)
+			refine(middle, latitude2, depth+1)
+			return
+		}
+		points = append(points, domain.GeoPoint{Latitude: latitude2, Longitude: longitude2})
+	}
+
+	points = append(points, domain.GeoPoint{Latitude: from, Longitude: longitudeAt(from)})
+	for latitude := from; latitude < to; latitude += step {
+		refine(latitude, math.Min(latitude+step, to), 0)
+	}
+
+	return splitAtAntimeridian(points)
+}
+
+// splitAtAntimeridian splits a line into segments where it crosses 180° longitude,
+// ending and starting the segments on the antimeridian
+func splitAtAntimeridian(points []domain.GeoPoint) [][]domain.GeoPoint {
+	if len(points) == 0 {
+		return nil
+	}
+
+	segments := [][]domain.GeoPoint{{points[0]}}
+	for i := 1; i < len(points); i++ {
+		previous, current := points[i-1], points[i]
+		delta := current.Longitude - previous.Longitude
+		if math.Abs(delta) > 180 {
+			// Crossing longitude on the side of the previous point
+			edge := 180.0
+			if previous.Longitude < 0 {
+				edge = -180
+			}
+			unwrapped := previous.Longitude + normalizeLongitude(delta)
+			fraction := (edge - previous.Longitude) / (unwrapped - previous.Longitude)
+			latitude := previous.Latitude + fraction*(current.Latitude-previous.Latitude)
+
+			last := len(segments) - 1
+			segments[last] = append(segments[last], domain.GeoPoint{Latitude: latitude, Longitude: edge})
+			segments = append(segments, []domain.GeoPoint{{Latitude: latitude, Longitude: -edge}})
+		}
+		last := len(segments) - 1
+		segments[last] = append(segments[last], current)
+	}
+
+	return segments
+}
+
+// FindNearestLines returns the lines passing within maxDistanceKm of a place, nearest first
+func (ac *AstrocartographyCalculator) FindNearestLines(
+	lines []domain.ACGLine,
+	location domain.Location,
+	maxDistanceKm float64,
+) []domain.ACGLineDistance {
+
+	var nearest []domain.ACGLineDistance
+	for _, line := range lines {
+		best := domain.ACGLineDistance{
+			Planet:     line.Planet,
+			Angle:      line.Angle,
+			DistanceKm: math.Inf(1),
+		}
+
+		for _, segment := range line.Segments {
+			for i := 1; i < len(segment); i++ {
+				from, to := segment[i-1], segment[i]
+				for s := 0; s <= acgDistanceSamples; s++ {
+					fraction := float64(s) / acgDistanceSamples
+					point := domain.GeoPoint{
+						Latitude:  from.Latitude + fraction*(to.Latitude-from.Latitude),
+						Longitude: from.Longitude + fraction*(to.Longitude-from.Longitude),
+					}
+					distance := location.DistanceTo(domain.Location{Latitude: point.Latitude, Longitude: point.Longitude})
+					if distance < best.DistanceKm {
+						best.DistanceKm = distance
+						best.Nearest = point
+					}
+				}
+			}
+		}
+
+		if best.DistanceKm <= maxDistanceKm {
+			best.DistanceKm = math.Round(best.DistanceKm*10) / 10
+			nearest = append(nearest, best)
+		}
+	}
+
+	sort.Slice(nearest, func(i, j int) bool {
+		return nearest[i].DistanceKm < nearest[j].DistanceKm
+	})
+
+	return nearest
+}
+
+// LinesToGeoJSON converts the lines to a feature collection with one MultiLineString per line
+func LinesToGeoJSON(lines []domain.ACGLine) *geojson.FeatureCollection {
+	collection := geojson.NewFeatureCollection()
+	for _, line := range lines {
+		var coordinates [][]geojson.Position
+		for _, segment := range line.Segments {
+			var positions []geojson.Position
+			for _, point := range segment {
+				positions = append(positions, geojson.Position{point.Longitude, point.Latitude})
+			}
+			coordinates = append(coordinates, positions)
+		}
+
+		collection.AddFeature(geojson.NewFeature(
+			geojson.NewMultiLineString(coordinates),
+			map[string]interface{}{
+				"name":   fmt.Sprintf("%s %s", line.Planet, line.Angle),
+				"planet": line.Planet,
+				"angle":  string(line.Angle),
+			},
+		))
+	}
+	return collection
+}
+
+// CalculateParans finds the latitudes where two planets are angular at the same moment of the day.
+// These are the latitudes where their lines cross; two meridian lines never cross, so pairs of
+// MC and IC lines are skipped. The latitudes are scanned with a fixed fine step rather than the
+// sampling step of the map lines, so that close crossings are not missed.
+func (ac *AstrocartographyCalculator) CalculateParans(planets []domain.Planet) []domain.ACGParan {
+	angles := domain.GetACGAngles()
+
+	var parans []domain.ACGParan
+	for i := 0; i < len(planets); i++ {
+		for j := i + 1; j < len(planets); j++ {
+			planet1, planet2 := planets[i], planets[j]
+			for _, angle1 := range angles {
+				for _, angle2 := range angles {
+					if isMeridianAngle(angle1) && isMeridianAngle(angle2) {
+						continue
+					}
+
+					// Sidereal time cancels out, so the lines are compared at Greenwich sidereal time 0
+					longitude1 := ac.getLineFunction(planet1.RightAscension, planet1.Declination, 0, angle1)
+					longitude2 := ac.getLineFunction(planet2.RightAscension, planet2.Declination, 0, angle2)
+					separation := func(latitude float64) float64 {
+						return normalizeLongitude(longitude1(latitude) - longitude2(latitude))
+					}
+
+					limit := math.Min(getLatitudeLimit(planet1, angle1), getLatitudeLimit(planet2, angle2))
+					for _, latitude := range findCrossings(separation, -limit, limit, acgParanStep) {
+						parans = append(parans, domain.ACGParan{
+							Planet1:  planet1.Name,
+							Angle1:   angle1,
+							Planet2:  planet2.Name,
+							Angle2:   angle2,
+							Latitude: math.Round(latitude*100) / 100,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(parans, func(i, j int) bool {
+		return parans[i].Latitude > parans[j].Latitude
+	})
+
+	return parans
+}
+
+// isMeridianAngle reports whether an angle is on the meridian (MC or IC)
+func isMeridianAngle(angle domain.ACGAngle) bool {
+	return angle == domain.ACGMidheaven || angle == domain.ACGIC
+}
+
+// findCrossings returns the latitudes where a longitude separation changes sign, ignoring
+// the jumps of the separation from +180° to -180°
+func findCrossings(separation func(float64) float64, from, to, step float64) []float64 {
+	var crossings []float64
+	for latitude := from; latitude < to; latitude += step {
+		low, high := latitude, math.Min(latitude+step, to)
+		valueLow, valueHigh := separation(low), separation(high)
+
+		if valueLow == 0 {
+			crossings = append(crossings, low)
+			continue
+		}
+		if valueLow*valueHigh >= 0 || math.Abs(valueLow-valueHigh) >= 180 {
+			continue
+		}
+
+		for high-low > acgParanPrecision/2 {
+			middle := (low + high) / 2
+			valueMiddle := separation(middle)
+			if valueLow*valueMiddle <= 0 {
+				high = middle
+			} else {
+				low, valueLow = middle, valueMiddle
+			}
+		}
+		crossings = append(crossings, (low+high)/2)
+	}
+	return crossings
+}
+
+// CalculateLocalSpace computes the azimuth and altitude of the planets from the birth place
+func (ac *AstrocartographyCalculator) CalculateLocalSpace(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	planets []domain.Planet,
+) ([]domain.LocalSpacePlanet, error) {
+
+	julianDay := ac.ephemeris.GetJulianDay(timeInfo)
+
+	var localSpace []domain.LocalSpacePlanet
+	for _, planet := range planets {
+		azimuth, altitude, err := ac.ephemeris.CalculateAzimuthAltitude(julianDay, location, planet.RightAscension, planet.Declination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate azimuth of %s: %w", planet.Name, err)
+		}
+
+		localSpace = append(localSpace, domain.LocalSpacePlanet{
+			Planet:    planet.Name,
+			Azimuth:   azimuth,
+			Altitude:  altitude,
+			Direction: domain.GetCompassDirection(azimuth),
+		})
+	}
+
+	return localSpace, nil
+}
+
+// FindCitiesAlongLine returns the cities whose bearing from the birth place is within orb
+// degrees of a local space line, in either direction, nearest first
+func (ac *AstrocartographyCalculator) FindCitiesAlongLine(
+	origin domain.Location,
+	azimuth float64,
+	cities []domain.Location,
+	orb float64,
+) []domain.LocalSpaceCity {
+
+	var along []domain.LocalSpaceCity
+	for _, city := range cities {
+		distance := origin.DistanceTo(city)
+		if distance < 1 {
+			// The birth place itself
+			continue
+		}
+
+		bearing := origin.BearingTo(city)
+		offset := math.Abs(normalizeLongitude(bearing - azimuth))
+		opposite := offset > 90
+		if opposite {
+			offset = 180 - offset
+		}
+
+		if offset <= orb {
+			along = append(along, domain.LocalSpaceCity{
+				City:       city.City,
+				Country:    city.Country,
+				Bearing:    math.Round(bearing*10) / 10,
+				DistanceKm: math.Round(distance*10) / 10,
+				Opposite:   opposite,
+			})
+		}
+	}
+
+	sort.Slice(along, func(i, j int) bool {
+		return along[i].DistanceKm < along[j].DistanceKm
+	})
+	if len(along) > localSpaceMaxCities {
+		along = along[:localSpaceMaxCities]
+	}
+
+	return along
+}
+
+// LocalSpaceToGeoJSON converts the local space lines to a feature collection. Each line is the
+// full great circle through the birth place with the planet's azimuth as initial bearing.
+func LocalSpaceToGeoJSON(origin domain.Location, localSpace []domain.LocalSpacePlanet) *geojson.FeatureCollection {
+	collection := geojson.NewFeatureCollection()
+	for _, planet := range localSpace {
+		var points []domain.GeoPoint
+		for arc := 0.0; arc <= 360; arc += localSpaceStep {
+			points = append(points, destinationPoint(origin, planet.Azimuth, arc))
+		}
+
+		var coordinates [][]geojson.Position
+		for _, segment := range splitAtAntimeridian(points) {
+			var positions []geojson.Position
+			for _, point := range segment {
+				positions = append(positions, geojson.Position{point.Longitude, point.Latitude})
+			}
+			coordinates = append(coordinates, positions)
+		}
+
+		collection.AddFeature(geojson.NewFeature(
+			geojson.NewMultiLineString(coordinates),
+			map[string]interface{}{
+				"name":    fmt.Sprintf("%s local space", planet.Planet),
+				"planet":  planet.Planet,
+				"azimuth": planet.Azimuth,
+			},
+		))
+	}
+	return collection
+}
+
+// destinationPoint returns the point reached from origin along a great circle with the
+// given initial bearing after an arc in degrees
+func destinationPoint(origin domain.Location, bearing, arc float64) domain.GeoPoint {
+	latitude1 := origin.Latitude * math.Pi / 180
+	longitude1 := origin.Longitude * math.Pi / 180
+	theta := bearing * math.Pi / 180
+	delta := arc * math.Pi / 180
+
+	latitude2 := math.Asin(math.Sin(latitude1)*math.Cos(delta) +
+		math.Cos(latitude1)*math.Sin(delta)*math.Cos(theta))
+	longitude2 := longitude1 + math.Atan2(
+		math.Sin(theta)*math.Sin(delta)*math.Cos(latitude1),
+		math.Cos(delta)-math.Sin(latitude1)*math.Sin(latitude2),
+	)
+
+	return domain.GeoPoint{
+		Latitude:  latitude2 * 180 / math.Pi,
+		Longitude: normalizeLongitude(longitude2 * 180 / math.Pi),
+	}
+}
+
+// normalizeLongitude ensures a longitude is between -180 and 180 degrees
+func normalizeLongitude(longitude float64) float64 {
+	longitude = normalizeAngle360(longitude)
+	if longitude >= 180 {
+		longitude -= 360
+	}
+	return longitude
+}
@@ -0,0 +1,400 @@
+package astro
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"astroeph-api/internal/domain"
+	"astroeph-api/pkg/geojson"
+)
+
+// withinTolerance reports whether two values differ by at most tolerance
+func withinTolerance(got, want, tolerance float64) bool {
+	return math.Abs(got-want) <= tolerance
+}
+
+func TestParseACGAngles(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []domain.ACGAngle
+		wantErr bool
+	}{
+		{"no names means all angles", nil, domain.GetACGAngles(), false},
+		{"case and spaces are ignored", []string{"mc", " Asc "}, []domain.ACGAngle{domain.ACGMidheaven, domain.ACGAscendant}, false},
+		{"unknown angle", []string{"MC", "VTX"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseACGAngles(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseACGAngles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseACGAngles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLatitudeLimit(t *testing.T) {
+	tests := []struct {
+		declination float64
+		angle       domain.ACGAngle
+		want        float64
+	}{
+		{20, domain.ACGAscendant, 70},
+		{-5, domain.ACGDescendant, 85},
+		{0.5, domain.ACGAscendant, acgMaxLatitude},
+		{60, domain.ACGMidheaven, acgMaxLatitude},
+	}
+
+	for _, tt := range tests {
+		planet := domain.Planet{Declination: tt.declination}
+		if got := getLatitudeLimit(planet, tt.angle); got != tt.want {
+			t.Errorf("getLatitudeLimit(%v, %s) = %v, want %v", tt.declination, tt.angle, got, tt.want)
+		}
+	}
+}
+
+func TestGetLineFunction(t *testing.T) {
+	tests := []struct {
+		name           string
+		rightAscension float64
+		declination    float64
+		siderealTime   float64
+		angle          domain.ACGAngle
+		latitude       float64
+		want           float64
+	}{
+		{"MC is the meridian of the right ascension", 30, 10, 0, domain.ACGMidheaven, 45, 30},
+		{"MC shifts with sidereal time", 30, 10, 100, domain.ACGMidheaven, 0, -70},
+		{"IC is opposite the MC", 30, 10, 0, domain.ACGIC, 0, -150},
+		{"ASC on the equator is a quadrant before the MC", 30, 20, 0, domain.ACGAscendant, 0, -60},
+		{"DSC on the equator is a quadrant after the MC", 30, 20, 0, domain.ACGDescendant, 0, 120},
+		{"ASC at 45° north with a northern declination", 0, 20, 0, domain.ACGAscendant, 45, -111.345},
+	}
+
+	ac := NewAstrocartographyCalculator(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			longitudeAt := ac.getLineFunction(tt.rightAscension, tt.declination, tt.siderealTime, tt.angle)
+			if got := longitudeAt(tt.latitude); !withinTolerance(got, tt.want, 0.001) {
+				t.Errorf("longitude at %v = %v, want %v", tt.latitude, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSampleLine(t *testing.T) {
+	tests := []struct {
+		name         string
+		longitudeAt  func(float64) float64
+		from, to     float64
+		step         float64
+		wantSegments []int // number of points of each segment
+	}{
+		{"meridian", func(float64) float64 { return 30 }, -10, 10, 5, []int{5}},
+		{"steep steps are refined", func(latitude float64) float64 { return latitude * 10 }, 0, 1, 1, []int{9}},
+		{"split at the antimeridian", func(latitude float64) float64 { return normalizeLongitude(172 + latitude) }, 0, 20, 5, []int{3, 4}},
+		{"empty range", func(float64) float64 { return 0 }, 10, 10, 1, nil},
+	}
+
+	ac := NewAstrocartographyCalculator(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments := ac.sampleLine(tt.longitudeAt, tt.from, tt.to, tt.step)
+			var got []int
+			for _, segment := range segments {
+				got = append(got, len(segment))
+			}
+			if !reflect.DeepEqual(got, tt.wantSegments) {
+				t.Errorf("sampleLine() segment lengths = %v, want %v", got, tt.wantSegments)
+			}
+		})
+	}
+}
+
+func TestSplitAtAntimeridian(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []domain.GeoPoint
+		want   [][]domain.GeoPoint
+	}{
+		{
+			name:   "no crossing",
+			points: []domain.GeoPoint{{Latitude: 0, Longitude: 10}, {Latitude: 10, Longitude: 20}},
+			want:   [][]domain.GeoPoint{{{Latitude: 0, Longitude: 10}, {Latitude: 10, Longitude: 20}}},
+		},
+		{
+			name:   "eastward crossing",
+			points: []domain.GeoPoint{{Latitude: 0, Longitude: 170}, {Latitude: 10, Longitude: -170}},
+			want: [][]domain.GeoPoint{
+				{{Latitude: 0, Longitude: 170}, {Latitude: 5, Longitude: 180}},
+				{{Latitude: 5, Longitude: -180}, {Latitude: 10, Longitude: -170}},
+			},
+		},
+		{
+			name:   "westward crossing",
+			points: []domain.GeoPoint{{Latitude: 0, Longitude: -170}, {Latitude: 10, Longitude: 170}},
+			want: [][]domain.GeoPoint{
+				{{Latitude: 0, Longitude: -170}, {Latitude: 5, Longitude: -180}},
+				{{Latitude: 5, Longitude: 180}, {Latitude: 10, Longitude: 170}},
+			},
+		},
+		{
+			name:   "no points",
+			points: nil,
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitAtAntimeridian(tt.points); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitAtAntimeridian() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindNearestLines(t *testing.T) {
+	meridian := func(planet string, longitude float64) domain.ACGLine {
+		return domain.ACGLine{
+			Planet: planet,
+			Angle:  domain.ACGMidheaven,
+			Segments: [][]domain.GeoPoint{{
+				{Latitude: -10, Longitude: longitude},
+				{Latitude: 10, Longitude: longitude},
+			}},
+		}
+	}
+	lines := []domain.ACGLine{meridian("Sun", 10), meridian("Moon", 2)}
+	location := domain.Location{Latitude: 0, Longitude: 0}
+
+	tests := []struct {
+		name          string
+		maxDistanceKm float64
+		wantPlanets   []string
+		wantDistances []float64
+	}{
+		{"nearest first", 2000, []string{"Moon", "Sun"}, []float64{222.4, 1111.9}},
+		{"beyond the radius", 1000, []string{"Moon"}, []float64{222.4}},
+		{"none in range", 100, nil, nil},
+	}
+
+	ac := NewAstrocartographyCalculator(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nearest := ac.FindNearestLines(lines, location, tt.maxDistanceKm)
+			if len(nearest) != len(tt.wantPlanets) {
+				t.Fatalf("FindNearestLines() = %+v, want planets %v", nearest, tt.wantPlanets)
+			}
+			for i, line := range nearest {
+				if line.Planet != tt.wantPlanets[i] || !withinTolerance(line.DistanceKm, tt.wantDistances[i], 0.1) {
+					t.Errorf("FindNearestLines()[%d] = %s at %v km, want %s at %v km",
+						i, line.Planet, line.DistanceKm, tt.wantPlanets[i], tt.wantDistances[i])
+				}
+				if line.Nearest.Latitude != 0 {
+					t.Errorf("FindNearestLines()[%d] nearest point = %+v, want latitude 0", i, line.Nearest)
+				}
+			}
+		})
+	}
+}
+
+func TestLinesToGeoJSON(t *testing.T) {
+	lines := []domain.ACGLine{{
+		Planet: "Venus",
+		Angle:  domain.ACGAscendant,
+		Segments: [][]domain.GeoPoint{
+			{{Latitude: 0, Longitude: 170}, {Latitude: 5, Longitude: 180}},
+			{{Latitude: 5, Longitude: -180}, {Latitude: 10, Longitude: -170}},
+		},
+	}}
+
+	collection := LinesToGeoJSON(lines)
+	if len(collection.Features) != 1 {
+		t.Fatalf("LinesToGeoJSON() has %d features, want 1", len(collection.Features))
+	}
+
+	feature := collection.Features[0]
+	if feature.Geometry.Type != geojson.TypeMultiLineString {
+		t.Errorf("geometry type = %s, want %s", feature.Geometry.Type, geojson.TypeMultiLineString)
+	}
+	wantCoordinates := [][]geojson.Position{
+		{{170, 0}, {180, 5}},
+		{{-180, 5}, {-170, 10}},
+	}
+	if !reflect.DeepEqual(feature.Geometry.Coordinates, wantCoordinates) {
+		t.Errorf("coordinates = %v, want %v", feature.Geometry.Coordinates, wantCoordinates)
+	}
+	if feature.Properties["name"] != "Venus ASC" || feature.Properties["angle"] != "ASC" {
+		t.Errorf("properties = %v", feature.Properties)
+	}
+}
+
+func TestFindCrossings(t *testing.T) {
+	tests := []struct {
+		name       string
+		separation func(float64) float64
+		from, to   float64
+		want       []float64
+	}{
+		{"single crossing", func(latitude float64) float64 { return latitude - 3.3 }, -10, 10, []float64{3.3}},
+		{"crossing on a step", func(latitude float64) float64 { return latitude }, -2, 2, []float64{0}},
+		{"jump at the antimeridian is not a crossing",
+			func(latitude float64) float64 { return normalizeLongitude(170 + latitude) }, 0, 20, nil},
+		{"two close crossings", func(latitude float64) float64 { return (latitude - 1.02) * (latitude - 1.28) }, 0, 2,
+			[]float64{1.02, 1.28}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findCrossings(tt.separation, tt.from, tt.to, acgParanStep)
+			if len(got) != len(tt.want) {
+				t.Fatalf("findCrossings() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !withinTolerance(got[i], tt.want[i], acgParanPrecision) {
+					t.Errorf("findCrossings()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateParans(t *testing.T) {
+	sun := domain.Planet{Name: "Sun", RightAscension: 0, Declination: 20}
+	moon := domain.Planet{Name: "Moon", RightAscension: 90, Declination: -10}
+	mars := domain.Planet{Name: "Mars", RightAscension: 30, Declination: 5}
+
+	tests := []struct {
+		name    string
+		planets []domain.Planet
+		want    domain.ACGParan
+	}{
+		{"culminating while the other rises on the equator", []domain.Planet{sun, moon},
+			domain.ACGParan{Planet1: "Sun", Angle1: domain.ACGMidheaven, Planet2: "Moon", Angle2: domain.ACGAscendant, Latitude: 0}},
+		{"setting while the other culminates", []domain.Planet{sun, mars},
+			domain.ACGParan{Planet1: "Sun", Angle1: domain.ACGDescendant, Planet2: "Mars", Angle2: domain.ACGMidheaven, Latitude: -67.2}},
+	}
+
+	ac := NewAstrocartographyCalculator(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parans := ac.CalculateParans(tt.planets)
+
+			found := false
+			for i, paran := range parans {
+				if isMeridianAngle(paran.Angle1) && isMeridianAngle(paran.Angle2) {
+					t.Errorf("CalculateParans() returned a meridian pair: %+v", paran)
+				}
+				if i > 0 && paran.Latitude > parans[i-1].Latitude {
+					t.Errorf("CalculateParans() is not sorted from north to south at %d", i)
+				}
+				if paran.Planet1 == tt.want.Planet1 && paran.Angle1 == tt.want.Angle1 &&
+					paran.Planet2 == tt.want.Planet2 && paran.Angle2 == tt.want.Angle2 &&
+					withinTolerance(paran.Latitude, tt.want.Latitude, acgParanPrecision) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("CalculateParans() = %+v, want it to contain %+v", parans, tt.want)
+			}
+		})
+	}
+}
+
+func TestDestinationPoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		origin  domain.Location
+		bearing float64
+		arc     float64
+		want    domain.GeoPoint
+	}{
+		{"east along the equator", domain.Location{}, 90, 90, domain.GeoPoint{Latitude: 0, Longitude: 90}},
+		{"north along the meridian", domain.Location{}, 0, 45, domain.GeoPoint{Latitude: 45, Longitude: 0}},
+		{"across the antimeridian", domain.Location{Longitude: 170}, 90, 20, domain.GeoPoint{Latitude: 0, Longitude: -170}},
+		{"no arc", domain.Location{Latitude: 40, Longitude: -3}, 135, 0, domain.GeoPoint{Latitude: 40, Longitude: -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := destinationPoint(tt.origin, tt.bearing, tt.arc)
+			if !withinTolerance(got.Latitude, tt.want.Latitude, 1e-9) || !withinTolerance(got.Longitude, tt.want.Longitude, 1e-9) {
+				t.Errorf("destinationPoint() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindCitiesAlongLine(t *testing.T) {
+	origin := domain.Location{City: "Origin", Latitude: 0, Longitude: 0}
+	cities := []domain.Location{
+		{City: "Far East", Latitude: 0, Longitude: 20},
+		{City: "East", Latitude: 0, Longitude: 10},
+		{City: "West", Latitude: 0, Longitude: -15},
+		{City: "North", Latitude: 10, Longitude: 0},
+		{City: "Off the line", Latitude: 0.5, Longitude: 5},
+		origin,
+	}
+
+	tests := []struct {
+		name         string
+		azimuth      float64
+		orb          float64
+		wantCities   []string
+		wantOpposite []bool
+	}{
+		{"both ends of the line, nearest first", 90, DefaultLocalSpaceOrb,
+			[]string{"East", "West", "Far East"}, []bool{false, true, false}},
+		{"wider orb", 90, 6, []string{"Off the line", "East", "West", "Far East"}, []bool{false, false, true, false}},
+		{"north and south", 180, DefaultLocalSpaceOrb, []string{"North"}, []bool{true}},
+	}
+
+	ac := NewAstrocartographyCalculator(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			along := ac.FindCitiesAlongLine(origin, tt.azimuth, cities, tt.orb)
+			var gotCities []string
+			var gotOpposite []bool
+			for _, city := range along {
+				gotCities = append(gotCities, city.City)
+				gotOpposite = append(gotOpposite, city.Opposite)
+			}
+			if !reflect.DeepEqual(gotCities, tt.wantCities) || !reflect.DeepEqual(gotOpposite, tt.wantOpposite) {
+				t.Errorf("FindCitiesAlongLine() = %v %v, want %v %v", gotCities, gotOpposite, tt.wantCities, tt.wantOpposite)
+			}
+		})
+	}
+}
+
+func TestLocalSpaceToGeoJSON(t *testing.T) {
+	localSpace := []domain.LocalSpacePlanet{{Planet: "Sun", Azimuth: 90}}
+
+	collection := LocalSpaceToGeoJSON(domain.Location{}, localSpace)
+	if len(collection.Features) != 1 {
+		t.Fatalf("LocalSpaceToGeoJSON() has %d features, want 1", len(collection.Features))
+	}
+
+	feature := collection.Features[0]
+	if feature.Properties["name"] != "Sun local space" {
+		t.Errorf("properties = %v", feature.Properties)
+	}
+	// The great circle along the equator leaves the map once at the antimeridian
+	coordinates, ok := feature.Geometry.Coordinates.([][]geojson.Position)
+	if !ok || len(coordinates) != 2 {
+		t.Fatalf("coordinates = %v, want two segments", feature.Geometry.Coordinates)
+	}
+	for _, segment := range coordinates {
+		for _, position := range segment {
+			if !withinTolerance(position[1], 0, 1e-9) {
+				t.Errorf("position %v is off the equator", position)
+			}
+		}
+	}
+}
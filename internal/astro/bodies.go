@@ -0,0 +1,104 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Optional bodies accepted by the "bodies" request option
+const (
+	BodyCeres            = "ceres"
+	BodyPallas           = "pallas"
+	BodyJuno             = "juno"
+	BodyVesta            = "vesta"
+	BodyTrueNode         = "true_node"
+	BodySouthNode        = "south_node"
+	BodyLilith           = "lilith"            // Mean Black Moon Lilith
+	BodyOsculatingLilith = "osculating_lilith" // Osculating (true) Black Moon Lilith
+)
+
+// asteroidPrefix selects a numbered asteroid, e.g. "asteroid:433" for Eros
+const asteroidPrefix = "asteroid:"
+
+// optionalBodyIDs maps optional body names to swephgo body IDs
+var optionalBodyIDs = map[string]int{
+	BodyCeres:            SE_CERES,
+	BodyPallas:           SE_PALLAS,
+	BodyJuno:             SE_JUNO,
+	BodyVesta:            SE_VESTA,
+	BodyTrueNode:         SE_TRUE_NODE,
+	BodyLilith:           SE_MEAN_APOG,
+	BodyOsculatingLilith: SE_OSCU_APOG,
+}
+
+// BodySelection holds the optional bodies requested on top of the default set
+type BodySelection struct {
+	PlanetIDs []int // Extra swephgo body IDs to calculate
+	SouthNode bool  // Whether to derive the South Node from the North Node
+}
+
+// GetDefaultBodyIDs returns the bodies calculated for every chart
+func GetDefaultBodyIDs() []int {
+	return []int{
+		SE_SUN, SE_MOON, SE_MERCURY, SE_VENUS, SE_MARS,
+		SE_JUPITER, SE_SATURN, SE_URANUS, SE_NEPTUNE, SE_PLUTO,
+		SE_MEAN_NODE, SE_CHIRON,
+	}
+}
+
+// GetAvailableBodies returns the optional body names (numbered asteroids use "asteroid:<number>")
+func GetAvailableBodies() []string {
+	return []string{
+		BodyCeres, BodyPallas, BodyJuno, BodyVesta,
+		BodyTrueNode, BodySouthNode, BodyLilith, BodyOsculatingLilith,
+	}
+}
+
+// GetPlanetNames returns the names of the default planets plus the selected bodies
+func (s *BodySelection) GetPlanetNames(ephemeris *Ephemeris) []string {
+	var names []string
+	for _, planetID := range append(GetDefaultBodyIDs(), s.PlanetIDs...) {
+		names = append(names, ephemeris.GetPlanetName(planetID))
+	}
+	if s.SouthNode {
+		names = append(names, string(domain.SouthNode))
+	}
+	return names
+}
+
+// ParseBodies converts body option names into a body selection
+func ParseBodies(bodies []string) (*BodySelection, error) {
+	selection := &BodySelection{}
+	seen := make(map[int]bool)
+
+	for _, body := range bodies {
+		name := strings.ToLower(strings.TrimSpace(body))
+
+		if name == BodySouthNode {
+			selection.SouthNode = true
+			continue
+		}
+
+		var planetID int
+		if id, exists := optionalBodyIDs[name]; exists {
+			planetID = id
+		} else if strings.HasPrefix(name, asteroidPrefix) {
+			number, err := strconv.Atoi(strings.TrimPrefix(name, asteroidPrefix))
+			if err != nil || number <= 0 {
+				return nil, fmt.Errorf("invalid asteroid number: %s", body)
+			}
+			planetID = SE_AST_OFFSET + number
+		} else {
+			return nil, fmt.Errorf("unknown body: %s", body)
+		}
+
+		if !seen[planetID] {
+			seen[planetID] = true
+			selection.PlanetIDs = append(selection.PlanetIDs, planetID)
+		}
+	}
+
+	return selection, nil
+}
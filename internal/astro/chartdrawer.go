@@ -3,6 +3,7 @@ package astro
 import (
 	"astroeph-api/internal/domain"
 	"astroeph-api/pkg/chart"
+	"fmt"
 	"time"
 )
 
@@ -92,6 +93,119 @@ func (cd *ChartDrawer) GenerateSynastryChart(
 	return cd.GenerateNatalChart(chart1, width, themeType)
 }
 
+// GenerateVargaChart generates a North- or South-Indian square chart for a divisional chart
+func (cd *ChartDrawer) GenerateVargaChart(
+	varga *domain.VargaChart,
+	style chart.IndianChartStyle,
+	width int,
+	themeType *chart.ThemeType,
+) (string, error) {
+
+	if width <= 0 {
+		width = cd.defaultWidth
+	}
+
+	theme := cd.defaultTheme
+	if themeType != nil {
+		theme = *themeType
+	}
+
+	data := &chart.IndianChartData{
+		Title:         varga.Code + " " + varga.Name,
+		AscendantSign: varga.Ascendant.SignNumber,
+	}
+	for _, placement := range varga.Placements {
+		data.Bodies = append(data.Bodies, chart.IndianChartBody{
+			Name:         placement.Name,
+			Sign:         placement.SignNumber,
+			IsRetrograde: placement.IsRetrograde,
+		})
+	}
+
+	response, err := chart.GenerateIndianChartSVG(data, style, width, &theme)
+	if err != nil {
+		return "", err
+	}
+
+	return response.SVG, nil
+}
+
+// GenerateDialChart generates a Uranian dial (360°, 90°, 45° or 22.5°) for planets and angles
+func (cd *ChartDrawer) GenerateDialChart(
+	planets []domain.Planet,
+	ascendant, midheaven float64,
+	dial float64,
+	width int,
+	themeType *chart.ThemeType,
+) (string, error) {
+
+	if width <= 0 {
+		width = cd.defaultWidth
+	}
+
+	theme := cd.defaultTheme
+	if themeType != nil {
+		theme = *themeType
+	}
+
+	data := &chart.DialChartData{
+		Title: fmt.Sprintf("%g° Dial", dial),
+		Dial:  dial,
+	}
+	for _, planet := range planets {
+		data.Bodies = append(data.Bodies, chart.DialBody{
+			Name:      planet.Name,
+			Longitude: planet.Longitude,
+		})
+	}
+	data.Bodies = append(data.Bodies,
+		chart.DialBody{Name: "Ascendant", Longitude: ascendant},
+		chart.DialBody{Name: "Midheaven", Longitude: midheaven},
+	)
+
+	response, err := chart.GenerateDialSVG(data, width, &theme)
+	if err != nil {
+		return "", err
+	}
+
+	return response.SVG, nil
+}
+
+// GenerateDispositorChart generates the dispositor tree of a chart
+func (cd *ChartDrawer) GenerateDispositorChart(
+	report *domain.DispositorReport,
+	width int,
+	themeType *chart.ThemeType,
+) (string, error) {
+
+	if width <= 0 {
+		width = cd.defaultWidth
+	}
+
+	theme := cd.defaultTheme
+	if themeType != nil {
+		theme = *themeType
+	}
+
+	data := &chart.DispositorChartData{
+		Title:           fmt.Sprintf("Dispositors (%s rulership)", report.Rulership),
+		FinalDispositor: report.FinalDispositor,
+	}
+	for _, dispositorChain := range report.Chains {
+		data.Nodes = append(data.Nodes, chart.DispositorNode{
+			Name:       dispositorChain.Planet,
+			Dispositor: report.Dispositors[dispositorChain.Planet],
+		})
+	}
+
+	response, err := chart.GenerateDispositorSVG(data, width, &theme)
+	if err != nil {
+		return "", err
+	}
+
+	return response.SVG, nil
+}
+
 // convertToRawChartData converts a domain chart to the format expected by pkg/chart
 func (cd *ChartDrawer) convertToRawChartData(domainChart *domain.Chart) *chart.RawChartData {
 	// Convert planets
@@ -105,6 +219,36 @@ func (cd *ChartDrawer) convertToRawChartData(domainChart *domain.Chart) *chart.R
 		rawPlanets = append(rawPlanets, rawPlanet)
 	}
 
+	// Sensitive points and lots are drawn as points on the wheel
+	for _, point := range domainChart.GetSensitivePoints() {
+		rawPlanets = append(rawPlanets, chart.RawPlanetData{
+			Name:      point.Name,
+			Longitude: point.Longitude,
+			Speed:     point.Speed,
+		})
+	}
+	for _, lot := range domainChart.Lots {
+		rawPlanets = append(rawPlanets, chart.RawPlanetData{
+			Name:      lot.Name,
+			Longitude: lot.Longitude,
+		})
+	}
+
+	// Aspects are passed as calculated so the chart matches the requested orbs and custom aspects
+	var rawAspects []chart.RawAspectData
+	for _, aspect := range domainChart.Aspects {
+		rawAspects = append(rawAspects, chart.RawAspectData{
+			Planet1:  aspect.Planet1,
+			Planet2:  aspect.Planet2,
+			Type:     string(aspect.Type),
+			Symbol:   aspect.Symbol,
+			Color:    aspect.Color,
+			Orb:      aspect.Orb,
+			Strength: aspect.Strength,
+			Applying: aspect.IsApplying,
+		})
+	}
+
 	// Convert house cusps
 	var houseCusps []float64
 	for _, house := range domainChart.Houses {
@@ -127,6 +271,7 @@ func (cd *ChartDrawer) convertToRawChartData(domainChart *domain.Chart) *chart.R
 		Ascendant:   domainChart.Angles.Ascendant.Value,
 		Midheaven:   domainChart.Angles.Midheaven.Value,
 		HouseSystem: domainChart.HouseSystem,
+		Aspects:     rawAspects,
 	}
 
 	return rawData
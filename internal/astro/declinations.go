@@ -0,0 +1,82 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+)
+
+// DefaultDeclinationOrb is the default orb in degrees for parallels and contraparallels
+const DefaultDeclinationOrb = 1.0
+
+// DeclinationCalculator handles declination aspects and out-of-bounds detection
+type DeclinationCalculator struct{}
+
+// NewDeclinationCalculator creates a new declination calculator
+func NewDeclinationCalculator() *DeclinationCalculator {
+	return &DeclinationCalculator{}
+}
+
+// MarkOutOfBounds flags the planets whose declination exceeds the Sun's greatest declination,
+// which equals the obliquity of the ecliptic for the date (see Ephemeris.GetObliquity)
+func (dc *DeclinationCalculator) MarkOutOfBounds(planets []domain.Planet, obliquity float64) {
+	for i := range planets {
+		planets[i].OutOfBounds = domain.IsOutOfBounds(planets[i].Declination, obliquity)
+	}
+}
+
+// CalculateDeclinationAspects finds parallels and contraparallels within a chart
+func (dc *DeclinationCalculator) CalculateDeclinationAspects(planets []domain.Planet, orb float64) []domain.DeclinationAspect {
+	var aspects []domain.DeclinationAspect
+
+	for i := 0; i < len(planets); i++ {
+		for j := i + 1; j < len(planets); j++ {
+			// The nodes always mirror each other's declination
+			if isNodePair(planets[i].Name, planets[j].Name) {
+				continue
+			}
+
+			aspect := domain.NewDeclinationAspect(
+				planets[i].Name, planets[j].Name,
+				planets[i].Declination, planets[j].Declination,
+				orb,
+			)
+			if aspect != nil {
+				aspects = append(aspects, *aspect)
+			}
+		}
+	}
+
+	return aspects
+}
+
+// CalculateDeclinationAspectsBetweenCharts finds parallels and contraparallels between two charts
+func (dc *DeclinationCalculator) CalculateDeclinationAspectsBetweenCharts(
+	chart1Planets, chart2Planets []domain.Planet,
+	orb float64,
+) []domain.DeclinationAspect {
+
+	var aspects []domain.DeclinationAspect
+
+	for _, planet1 := range chart1Planets {
+		for _, planet2 := range chart2Planets {
+			aspect := domain.NewDeclinationAspect(
+				planet1.Name, planet2.Name,
+				planet1.Declination, planet2.Declination,
+				orb,
+			)
+			if aspect != nil {
+				aspects = append(aspects, *aspect)
+			}
+		}
+	}
+
+	return aspects
+}
+
+// isNodePair returns true for a North Node / South Node pair
+func isNodePair(name1, name2 string) bool {
+	isNorth := func(name string) bool {
+		return name == string(domain.NorthNode) || name == string(domain.TrueNode)
+	}
+	return (isNorth(name1) && name2 == string(domain.SouthNode)) ||
+		(isNorth(name2) && name1 == string(domain.SouthNode))
+}
@@ -0,0 +1,128 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"math"
+)
+
+// syzygyIterations is the number of refinements when searching for the prenatal syzygy
+const syzygyIterations = 5
+
+// DignityCalculator handles essential dignities and almutens
+type DignityCalculator struct {
+	ephemeris *Ephemeris
+}
+
+// NewDignityCalculator creates a new dignity calculator
+func NewDignityCalculator(ephemeris *Ephemeris) *DignityCalculator {
+	return &DignityCalculator{
+		ephemeris: ephemeris,
+	}
+}
+
+// CalculateDignities scores the essential dignities of the seven traditional planets
+// (and of the outer planets with modern rulership), finds the almuten figuris and sets
+// the almuten of each house cusp.
+//
+// The almuten figuris is the planet with most dignity over the Sun, Moon, Ascendant,
+// Lot of Fortune and prenatal syzygy.
+func (dc *DignityCalculator) CalculateDignities(
+	timeInfo *domain.TimeInfo,
+	planets []domain.Planet,
+	houses []domain.House,
+	ascendant float64,
+	isNight bool,
+	settings domain.DignitySettings,
+) (*domain.DignityReport, error) {
+
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &domain.DignityReport{
+		Settings:     settings,
+		IsNightChart: isNight,
+	}
+
+	var sun, moon *domain.Planet
+	for i := range planets {
+		planet := &planets[i]
+		switch planet.Name {
+		case string(domain.Sun):
+			sun = planet
+		case string(domain.Moon):
+			moon = planet
+		}
+
+		if !domain.HasEssentialDignities(planet.Name, settings.Rulership) {
+			continue
+		}
+		report.Planets = append(report.Planets,
+			domain.NewEssentialDignity(planet.Name, planet.Longitude, isNight, settings))
+	}
+
+	for i := range houses {
+		houses[i].Almuten, _ = domain.GetAlmuten([]float64{houses[i].CuspValue}, isNight, settings)
+	}
+
+	if sun == nil || moon == nil {
+		return report, nil
+	}
+
+	syzygy, err := dc.FindPrenatalSyzygy(dc.ephemeris.GetJulianDay(timeInfo), *sun, *moon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find prenatal syzygy: %w", err)
+	}
+	report.PrenatalSyzygy = syzygy
+
+	fortune := ascendant + moon.Longitude - sun.Longitude
+	if isNight {
+		fortune = ascendant + sun.Longitude - moon.Longitude
+	}
+
+	report.AlmutenFiguris, report.AlmutenScores = domain.GetAlmuten(
+		[]float64{sun.Longitude, moon.Longitude, ascendant, normalizeAngle360(fortune), syzygy},
+		isNight,
+		settings,
+	)
+
+	return report, nil
+}
+
+// FindPrenatalSyzygy returns the longitude of the last new or full moon before birth.
+// For a full moon the Moon's longitude is used.
+func (dc *DignityCalculator) FindPrenatalSyzygy(julianDay float64, sun, moon domain.Planet) (float64, error) {
+	relativeSpeed := moon.Speed - sun.Speed
+	if relativeSpeed <= 0 {
+		return 0, fmt.Errorf("invalid lunar motion")
+	}
+
+	// Pick the most recent of the two lunations from the elongation and mean motion
+	elongation := normalizeAngle360(moon.Longitude - sun.Longitude)
+	target := 0.0
+	if elongation >= 180 {
+		target = 180
+	}
+	jd := julianDay - (elongation-target)/relativeSpeed
+
+	var sunPos, moonPos *PlanetPosition
+	for i := 0; i < syzygyIterations; i++ {
+		var err error
+		if sunPos, err = dc.ephemeris.CalculatePlanetPosition(jd, SE_SUN); err != nil {
+			return 0, err
+		}
+		if moonPos, err = dc.ephemeris.CalculatePlanetPosition(jd, SE_MOON); err != nil {
+			return 0, err
+		}
+
+		// Signed distance from the exact lunation, in -180..180
+		diff := math.Mod(moonPos.Longitude-sunPos.Longitude-target+540, 360) - 180
+		jd -= diff / (moonPos.LongSpeed - sunPos.LongSpeed)
+	}
+
+	if target == 0 {
+		return sunPos.Longitude, nil
+	}
+	return moonPos.Longitude, nil
+}
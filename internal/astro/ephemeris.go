@@ -3,12 +3,20 @@ package astro
 import (
 	"astroeph-api/internal/domain"
 	"astroeph-api/internal/logging"
+	"bytes"
 	"fmt"
 	"math"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/mshafiee/swephgo"
 )
 
+// siderealModeMutex serializes setting the global Swiss Ephemeris sidereal mode and reading
+// the ayanamsa back, so concurrent requests with different ayanamsas cannot interleave
+var siderealModeMutex sync.Mutex
+
 // Ephemeris provides a wrapper around Swiss Ephemeris (swephgo)
 type Ephemeris struct {
 	logger      *logging.Logger
@@ -29,7 +37,48 @@ const (
 	SE_PLUTO     = 9
 	SE_MEAN_NODE = 10
 	SE_TRUE_NODE = 11
+	SE_MEAN_APOG = 12 // Mean lunar apogee (Black Moon Lilith)
+	SE_OSCU_APOG = 13 // Osculating lunar apogee
 	SE_CHIRON    = 15
+	SE_CERES     = 17
+	SE_PALLAS    = 18
+	SE_JUNO      = 19
+	SE_VESTA     = 20
+
+	SE_AST_OFFSET = 10000 // Numbered asteroids are SE_AST_OFFSET + MPC number
+
+	SE_ECL_NUT = -1 // Obliquity of the ecliptic and nutation
+)
+
+// Calculation flags for swephgo
+const (
+	SEFLG_SWIEPH     = 2
+	SEFLG_SPEED      = 256
+	SEFLG_EQUATORIAL = 2048
+)
+
+// Rise/set/transit event constants for swephgo
+const (
+	SE_CALC_RISE     = 1
+	SE_CALC_SET      = 2
+	SE_CALC_MTRANSIT = 4
+	SE_CALC_ITRANSIT = 8
+)
+
+// Horizontal coordinate conversion constants for swephgo
+const (
+	SE_ECL2HOR = 0
+	SE_EQU2HOR = 1
+)
+
+// Sidereal mode (ayanamsa) constants for swephgo
+const (
+	SE_SIDM_FAGAN_BRADLEY = 0
+	SE_SIDM_LAHIRI        = 1
+	SE_SIDM_RAMAN         = 3
+	SE_SIDM_KRISHNAMURTI  = 5
+	SE_SIDM_YUKTESHWAR    = 7
+	SE_SIDM_TRUE_CITRA    = 27
 )
 
 // NewEphemeris creates a new Ephemeris instance
@@ -47,8 +96,9 @@ func NewEphemeris(logger *logging.Logger) (*Ephemeris, error) {
 
 // initialize initializes the Swiss Ephemeris
 func (e *Ephemeris) initialize() error {
-	// Set ephemeris path - in production, you might want to set a specific path
-	swephgo.SetEphePath([]byte("")) // Use built-in ephemeris data
+	// Set ephemeris path - SE_EPHE_PATH points to a directory with .se1 files
+	// (needed for asteroids); without it the built-in ephemeris data is used
+	swephgo.SetEphePath([]byte(os.Getenv("SE_EPHE_PATH")))
 
 	e.logger.Info().Msg("🔮 Initializing Swiss Ephemeris")
 
@@ -83,7 +133,7 @@ func (e *Ephemeris) CalculatePlanetPosition(julianDay float64, planetID int) (*P
 
 	xx := make([]float64, 6)
 	serr := make([]byte, 256)
-	result := swephgo.Calc(julianDay, planetID, 0, xx, serr)
+	result := swephgo.CalcUt(julianDay, planetID, SEFLG_SWIEPH|SEFLG_SPEED, xx, serr)
 
 	if result < 0 {
 		return nil, fmt.Errorf("failed to calculate position for planet %d: %s", planetID, string(serr))
@@ -99,20 +149,42 @@ func (e *Ephemeris) CalculatePlanetPosition(julianDay float64, planetID int) (*P
 		DistSpeed: xx[5],
 	}
 
+	// Equatorial coordinates (right ascension and declination)
+	result = swephgo.CalcUt(julianDay, planetID, SEFLG_SWIEPH|SEFLG_SPEED|SEFLG_EQUATORIAL, xx, serr)
+	if result < 0 {
+		return nil, fmt.Errorf("failed to calculate equatorial position for planet %d: %s", planetID, string(serr))
+	}
+	pos.RightAscension = xx[0]
+	pos.Declination = xx[1]
+	pos.DeclSpeed = xx[4]
+
 	return pos, nil
 }
 
+// GetObliquity returns the true obliquity of the ecliptic for a Julian Day (UT).
+// This is also the Sun's greatest declination for that date.
+func (e *Ephemeris) GetObliquity(julianDay float64) (float64, error) {
+	if !e.initialized {
+		return 0, fmt.Errorf("ephemeris not initialized")
+	}
+
+	xx := make([]float64, 6)
+	serr := make([]byte, 256)
+	result := swephgo.CalcUt(julianDay, SE_ECL_NUT, 0, xx, serr)
+	if result < 0 {
+		return 0, fmt.Errorf("failed to calculate obliquity: %s", string(serr))
+	}
+
+	return xx[0], nil
+}
+
 // CalculateAllPlanets calculates positions for all main planets
 func (e *Ephemeris) CalculateAllPlanets(julianDay float64) ([]PlanetPosition, error) {
 	if !e.initialized {
 		return nil, fmt.Errorf("ephemeris not initialized")
 	}
 
-	mainPlanets := []int{
-		SE_SUN, SE_MOON, SE_MERCURY, SE_VENUS, SE_MARS,
-		SE_JUPITER, SE_SATURN, SE_URANUS, SE_NEPTUNE, SE_PLUTO,
-		SE_MEAN_NODE, SE_CHIRON,
-	}
+	mainPlanets := GetDefaultBodyIDs()
 
 	var positions []PlanetPosition
 
@@ -140,29 +212,181 @@ func (e *Ephemeris) CalculateHouses(julianDay, latitude, longitude float64, hous
 	// Calculate houses using swephgo
 	cusps := make([]float64, 13) // 0-12, where 1-12 are the house cusps
 	ascmc := make([]float64, 10) // Ascendant, MC, etc.
-	result := swephgo.Houses(julianDay, latitude, longitude, int(houseSystem), cusps, ascmc)
+	cuspSpeeds := make([]float64, 13)
+	ascmcSpeeds := make([]float64, 10)
+	serr := make([]byte, 256)
+	result := swephgo.HousesEx2(julianDay, 0, latitude, longitude, int(houseSystem), cusps, ascmc, cuspSpeeds, ascmcSpeeds, serr)
 
 	if result < 0 {
-		return nil, fmt.Errorf("failed to calculate houses: house system not supported or invalid parameters")
+		return nil, fmt.Errorf("failed to calculate houses for system %c: %s", houseSystem, cString(serr))
 	}
 
 	housesData := &HousesData{
 		Cusps:         cusps[1:13], // Houses 1-12
-		Ascendant:     cusps[1],    // 1st house cusp is the Ascendant
-		Midheaven:     cusps[10],   // 10th house cusp is the Midheaven
-		IC:            cusps[4],    // 4th house cusp is the IC
-		Descendant:    cusps[7],    // 7th house cusp is the Descendant
-		ARMC:          ascmc[2],    // Right Ascension of MC
-		Vertex:        ascmc[3],    // Vertex
-		EquatorialAsc: ascmc[4],    // Equatorial Ascendant
-		CoAscendant1:  ascmc[5],    // Co-ascendant (Koch)
-		CoAscendant2:  ascmc[6],    // Co-ascendant (Munkasey)
-		PolarAsc:      ascmc[7],    // Polar ascendant
+		CuspSpeeds:    cuspSpeeds[1:13],
+		Ascendant:     cusps[1],  // 1st house cusp is the Ascendant
+		Midheaven:     cusps[10], // 10th house cusp is the Midheaven
+		IC:            cusps[4],  // 4th house cusp is the IC
+		Descendant:    cusps[7],  // 7th house cusp is the Descendant
+		ARMC:          ascmc[2],  // Right Ascension of MC
+		Vertex:        ascmc[3],  // Vertex
+		EquatorialAsc: ascmc[4],  // Equatorial Ascendant
+		CoAscendant1:  ascmc[5],  // Co-ascendant (Koch)
+		CoAscendant2:  ascmc[6],  // Co-ascendant (Munkasey)
+		PolarAsc:      ascmc[7],  // Polar ascendant
+
+		VertexSpeed:        ascmcSpeeds[3],
+		EquatorialAscSpeed: ascmcSpeeds[4],
+		CoAscendant1Speed:  ascmcSpeeds[5],
+		CoAscendant2Speed:  ascmcSpeeds[6],
+		PolarAscSpeed:      ascmcSpeeds[7],
 	}
 
 	return housesData, nil
 }
 
+// CalculateHousePosition returns the fractional house position of a point from its ecliptic
+// longitude and latitude: 1.0 to 12.999, or 1.0 to 36.999 for Gauquelin sectors
+func (e *Ephemeris) CalculateHousePosition(armc, geoLatitude, obliquity float64, houseSystem rune, longitude, latitude float64) (float64, error) {
+	if !e.initialized {
+		return 0, fmt.Errorf("ephemeris not initialized")
+	}
+
+	xpin := []float64{longitude, latitude}
+	serr := make([]byte, 256)
+	position := swephgo.HousePos(armc, geoLatitude, obliquity, int(houseSystem), xpin, serr)
+	if message := cString(serr); message != "" || position < 1 {
+		return 0, fmt.Errorf("failed to calculate house position for system %c: %s", houseSystem, message)
+	}
+
+	return position, nil
+}
+
+// GetARMC returns the right ascension of the Midheaven for a Julian Day (UT) and geographic longitude
+func (e *Ephemeris) GetARMC(julianDay, longitude float64) float64 {
+	return normalizeAngle360(swephgo.Sidtime(julianDay)*15 + longitude)
+}
+
+// CalculateAzimuthAltitude converts the equatorial position of a body to the horizon of a place
+// at a Julian Day (UT). The azimuth is measured from north through east and the altitude is
+// the true altitude, without refraction.
+func (e *Ephemeris) CalculateAzimuthAltitude(julianDay float64, location *domain.Location, rightAscension, declination float64) (float64, float64, error) {
+	if !e.initialized {
+		return 0, 0, fmt.Errorf("ephemeris not initialized")
+	}
+
+	geopos := []float64{location.Longitude, location.Latitude, location.Elevation}
+	xin := []float64{rightAscension, declination, 1}
+	xaz := make([]float64, 3)
+	swephgo.Azalt(julianDay, SE_EQU2HOR, geopos, 0, 0, xin, xaz)
+
+	// Swiss Ephemeris measures the azimuth from south through west
+	return normalizeAngle360(xaz[0] + 180), xaz[1], nil
+}
+
+// CalculateRiseSet finds the next rise, set or meridian transit of a planet after the given Julian Day (UT)
+func (e *Ephemeris) CalculateRiseSet(julianDay float64, planetID int, location *domain.Location, event int) (float64, error) {
+	return e.riseTrans(julianDay, planetID, nil, location, event)
+}
+
+// CalculateStarRiseSet finds the next rise, set or meridian transit of a fixed star after the given Julian Day (UT)
+func (e *Ephemeris) CalculateStarRiseSet(julianDay float64, star string, location *domain.Location, event int) (float64, error) {
+	return e.riseTrans(julianDay, 0, starNameBuffer(star), location, event)
+}
+
+// riseTrans wraps swephgo.RiseTrans for planets (starName nil) and fixed stars
+func (e *Ephemeris) riseTrans(julianDay float64, planetID int, starName []byte, location *domain.Location, event int) (float64, error) {
+	if !e.initialized {
+		return 0, fmt.Errorf("ephemeris not initialized")
+	}
+
+	body := fmt.Sprintf("planet %d", planetID)
+	if starName != nil {
+		body = fmt.Sprintf("star %s", cString(starName))
+	}
+
+	geopos := []float64{location.Longitude, location.Latitude, location.Elevation}
+	tret := make([]float64, 10)
+	serr := make([]byte, 256)
+	result := swephgo.RiseTrans(julianDay, planetID, starName, SEFLG_SWIEPH, event, geopos, 0, 0, tret, serr)
+
+	if result == -2 {
+		return 0, fmt.Errorf("%s does not rise or set at latitude %.2f (circumpolar)", body, location.Latitude)
+	}
+	if result < 0 {
+		return 0, fmt.Errorf("failed to calculate rise/set for %s: %s", body, cString(serr))
+	}
+
+	return tret[0], nil
+}
+
+// CalculateFixedStar calculates the ecliptic position of a fixed star from the sefstars.txt catalog
+func (e *Ephemeris) CalculateFixedStar(julianDay float64, star string) (*FixedStarPosition, error) {
+	if !e.initialized {
+		return nil, fmt.Errorf("ephemeris not initialized")
+	}
+
+	// The star buffer receives the full catalog name ("Name,designation")
+	starName := starNameBuffer(star)
+	xx := make([]float64, 6)
+	serr := make([]byte, 256)
+	result := swephgo.Fixstar2Ut(starName, julianDay, SEFLG_SWIEPH|SEFLG_SPEED, xx, serr)
+
+	if result < 0 {
+		return nil, fmt.Errorf("failed to calculate fixed star %s: %s", star, cString(serr))
+	}
+
+	magnitude := make([]float64, 1)
+	if swephgo.Fixstar2Mag(starNameBuffer(star), magnitude, serr) < 0 {
+		magnitude[0] = 0
+	}
+
+	return &FixedStarPosition{
+		CatalogName: cString(starName),
+		Longitude:   xx[0],
+		Latitude:    xx[1],
+		Distance:    xx[2],
+		LongSpeed:   xx[3],
+		Magnitude:   magnitude[0],
+	}, nil
+}
+
+// GetAyanamsa returns the ayanamsa in degrees for the given sidereal mode and Julian Day (UT)
+func (e *Ephemeris) GetAyanamsa(julianDay float64, siderealMode int) (float64, error) {
+	if !e.initialized {
+		return 0, fmt.Errorf("ephemeris not initialized")
+	}
+
+	siderealModeMutex.Lock()
+	swephgo.SetSidMode(siderealMode, 0, 0)
+	ayanamsa := swephgo.GetAyanamsaUt(julianDay)
+	siderealModeMutex.Unlock()
+
+	if math.IsNaN(ayanamsa) {
+		return 0, fmt.Errorf("failed to calculate ayanamsa for sidereal mode %d", siderealMode)
+	}
+
+	return ayanamsa, nil
+}
+
+// GetSiderealModeCode converts an ayanamsa name to swephgo sidereal mode code
+func (e *Ephemeris) GetSiderealModeCode(ayanamsa string) int {
+	switch ayanamsa {
+	case "Fagan-Bradley":
+		return SE_SIDM_FAGAN_BRADLEY
+	case "Raman":
+		return SE_SIDM_RAMAN
+	case "Krishnamurti":
+		return SE_SIDM_KRISHNAMURTI
+	case "Yukteshwar":
+		return SE_SIDM_YUKTESHWAR
+	case "True Chitra":
+		return SE_SIDM_TRUE_CITRA
+	default:
+		return SE_SIDM_LAHIRI // Default to Lahiri (Chitrapaksha)
+	}
+}
+
 // GetJulianDay converts a date/time to Julian Day Number
 func (e *Ephemeris) GetJulianDay(timeInfo *domain.TimeInfo) float64 {
 	utc := timeInfo.UTCTime
@@ -170,6 +394,13 @@ func (e *Ephemeris) GetJulianDay(timeInfo *domain.TimeInfo) float64 {
 	return swephgo.Julday(utc.Year(), int(utc.Month()), utc.Day(), hour, 1)
 }
 
+// GetLocalMidnightJulianDay returns the Julian Day (UT) of the local midnight starting the given day
+func (e *Ephemeris) GetLocalMidnightJulianDay(timeInfo *domain.TimeInfo) float64 {
+	local := timeInfo.LocalTime
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	return e.GetJulianDay(&domain.TimeInfo{UTCTime: midnight.UTC()})
+}
+
 // GetPlanetName returns the name of a planet by its ID
 func (e *Ephemeris) GetPlanetName(planetID int) string {
 	planetNames := map[int]string{
@@ -184,17 +415,28 @@ func (e *Ephemeris) GetPlanetName(planetID int) string {
 		SE_NEPTUNE:   "Neptune",
 		SE_PLUTO:     "Pluto",
 		SE_MEAN_NODE: "North Node",
+		SE_TRUE_NODE: "True Node",
+		SE_MEAN_APOG: "Lilith",
+		SE_OSCU_APOG: "Osculating Lilith",
 		SE_CHIRON:    "Chiron",
+		SE_CERES:     "Ceres",
+		SE_PALLAS:    "Pallas",
+		SE_JUNO:      "Juno",
+		SE_VESTA:     "Vesta",
 	}
 
 	if name, exists := planetNames[planetID]; exists {
 		return name
 	}
+	if planetID > SE_AST_OFFSET {
+		return fmt.Sprintf("Asteroid %d", planetID-SE_AST_OFFSET)
+	}
 	return fmt.Sprintf("Planet_%d", planetID)
 }
 
-// GetHouseSystemCode converts house system name to swephgo code
-func (e *Ephemeris) GetHouseSystemCode(system string) rune {
+// GetHouseSystemCode converts house system name to swephgo code.
+// An empty name is Placidus; an unknown name is an error.
+func (e *Ephemeris) GetHouseSystemCode(system string) (rune, error) {
 	const (
 		SE_HOUSE_PLACIDUS      = 'P'
 		SE_HOUSE_KOCH          = 'K'
@@ -203,23 +445,55 @@ func (e *Ephemeris) GetHouseSystemCode(system string) rune {
 		SE_HOUSE_CAMPANUS      = 'C'
 		SE_HOUSE_EQUAL         = 'E'
 		SE_HOUSE_WHOLE_SIGN    = 'W'
+		SE_HOUSE_ALCABITIUS    = 'B'
+		SE_HOUSE_MORINUS       = 'M'
+		SE_HOUSE_TOPOCENTRIC   = 'T' // Polich-Page
+		SE_HOUSE_MERIDIAN      = 'X'
+		SE_HOUSE_VEHLOW        = 'V'
+		SE_HOUSE_SRIPATI       = 'S'
+		SE_HOUSE_KRUSINSKI     = 'U'
+		SE_HOUSE_APC           = 'Y'
+		SE_HOUSE_HORIZONTAL    = 'H'
+		SE_HOUSE_SUNSHINE      = 'I'
 	)
 
 	switch system {
+	case "", "Placidus":
+		return SE_HOUSE_PLACIDUS, nil
 	case "Koch":
-		return SE_HOUSE_KOCH
+		return SE_HOUSE_KOCH, nil
 	case "Porphyrius":
-		return SE_HOUSE_PORPHYRIUS
+		return SE_HOUSE_PORPHYRIUS, nil
 	case "Regiomontanus":
-		return SE_HOUSE_REGIOMONTANUS
+		return SE_HOUSE_REGIOMONTANUS, nil
 	case "Campanus":
-		return SE_HOUSE_CAMPANUS
+		return SE_HOUSE_CAMPANUS, nil
 	case "Equal":
-		return SE_HOUSE_EQUAL
+		return SE_HOUSE_EQUAL, nil
 	case "Whole Sign":
-		return SE_HOUSE_WHOLE_SIGN
+		return SE_HOUSE_WHOLE_SIGN, nil
+	case "Alcabitius":
+		return SE_HOUSE_ALCABITIUS, nil
+	case "Morinus":
+		return SE_HOUSE_MORINUS, nil
+	case "Topocentric", "Polich-Page":
+		return SE_HOUSE_TOPOCENTRIC, nil
+	case "Meridian":
+		return SE_HOUSE_MERIDIAN, nil
+	case "Vehlow":
+		return SE_HOUSE_VEHLOW, nil
+	case "Sripati":
+		return SE_HOUSE_SRIPATI, nil
+	case "Krusinski":
+		return SE_HOUSE_KRUSINSKI, nil
+	case "APC":
+		return SE_HOUSE_APC, nil
+	case "Horizontal":
+		return SE_HOUSE_HORIZONTAL, nil
+	case "Sunshine":
+		return SE_HOUSE_SUNSHINE, nil
 	default:
-		return SE_HOUSE_PLACIDUS // Default to Placidus
+		return 0, fmt.Errorf("unsupported house system: %s", system)
 	}
 }
 
@@ -232,11 +506,26 @@ type PlanetPosition struct {
 	LongSpeed float64 `json:"long_speed"` // Longitude speed in degrees/day
 	LatSpeed  float64 `json:"lat_speed"`  // Latitude speed in degrees/day
 	DistSpeed float64 `json:"dist_speed"` // Distance speed in AU/day
+
+	RightAscension float64 `json:"right_ascension"` // Right ascension in degrees
+	Declination    float64 `json:"declination"`     // Declination in degrees
+	DeclSpeed      float64 `json:"decl_speed"`      // Declination speed in degrees/day
+}
+
+// FixedStarPosition holds calculated fixed star data
+type FixedStarPosition struct {
+	CatalogName string  `json:"catalog_name"` // "Name,designation" as found in sefstars.txt
+	Longitude   float64 `json:"longitude"`    // Longitude in degrees
+	Latitude    float64 `json:"latitude"`     // Latitude in degrees
+	Distance    float64 `json:"distance"`     // Distance in AU
+	LongSpeed   float64 `json:"long_speed"`   // Longitude speed (precession) in degrees/day
+	Magnitude   float64 `json:"magnitude"`    // Visual magnitude
 }
 
 // HousesData holds calculated house data
 type HousesData struct {
 	Cusps         []float64 `json:"cusps"`          // House cusps 1-12
+	CuspSpeeds    []float64 `json:"cusp_speeds"`    // Daily motion of house cusps 1-12 in degrees
 	Ascendant     float64   `json:"ascendant"`      // Ascendant (1st house cusp)
 	Midheaven     float64   `json:"midheaven"`      // Midheaven (10th house cusp)
 	IC            float64   `json:"ic"`             // IC (4th house cusp)
@@ -247,6 +536,15 @@ type HousesData struct {
 	CoAscendant1  float64   `json:"co_ascendant1"`  // Co-ascendant (Koch)
 	CoAscendant2  float64   `json:"co_ascendant2"`  // Co-ascendant (Munkasey)
 	PolarAsc      float64   `json:"polar_asc"`      // Polar ascendant
+
+	// Daily motion of the sensitive points in degrees
+	VertexSpeed        float64 `json:"vertex_speed"`
+	EquatorialAscSpeed float64 `json:"equatorial_asc_speed"`
+	CoAscendant1Speed  float64 `json:"co_ascendant1_speed"`
+	CoAscendant2Speed  float64 `json:"co_ascendant2_speed"`
+	PolarAscSpeed      float64 `json:"polar_asc_speed"`
+
+	HouseSystem domain.HouseSystem `json:"house_system"` // System actually used, set by the house calculator
 }
 
 // IsRetrograde returns true if the planet is moving retrograde
@@ -266,11 +564,28 @@ func (p PlanetPosition) GetSign() string {
 
 // ToDomainPlanet converts ephemeris data to domain planet
 func (p PlanetPosition) ToDomainPlanet(ephemeris *Ephemeris, houseNumber int) domain.Planet {
-	return domain.NewPlanet(
+	planet := domain.NewPlanet(
 		ephemeris.GetPlanetName(p.PlanetID),
 		p.Longitude,
 		p.Latitude,
 		p.LongSpeed,
 		houseNumber,
 	)
+	planet.SetEquatorial(p.RightAscension, p.Declination)
+	return planet
+}
+
+// starNameBuffer returns a NUL-terminated buffer large enough for swephgo to write back the catalog name
+func starNameBuffer(star string) []byte {
+	buffer := make([]byte, 256)
+	copy(buffer, star)
+	return buffer
+}
+
+// cString converts a NUL-terminated byte buffer to a string
+func cString(buffer []byte) string {
+	if i := bytes.IndexByte(buffer, 0); i >= 0 {
+		return string(buffer[:i])
+	}
+	return string(buffer)
 }
@@ -0,0 +1,217 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"math"
+	"time"
+)
+
+// FixedStarCalculator handles fixed star positions, conjunctions and parans
+type FixedStarCalculator struct {
+	ephemeris *Ephemeris
+}
+
+// NewFixedStarCalculator creates a new fixed star calculator
+func NewFixedStarCalculator(ephemeris *Ephemeris) *FixedStarCalculator {
+	return &FixedStarCalculator{
+		ephemeris: ephemeris,
+	}
+}
+
+// Default orbs for fixed star contacts
+const (
+	DefaultStarConjunctionOrb = 1.0 // degrees of ecliptic longitude
+	DefaultStarParanOrb       = 4.0 // minutes of time (about 1° of right ascension)
+)
+
+// diurnalEvents maps event names to swephgo rise/transit flags
+var diurnalEvents = []struct {
+	name string
+	flag int
+}{
+	{domain.EventRising, SE_CALC_RISE},
+	{domain.EventCulminating, SE_CALC_MTRANSIT},
+	{domain.EventSetting, SE_CALC_SET},
+	{domain.EventAntiCulminating, SE_CALC_ITRANSIT},
+}
+
+// paranPlanets lists the bodies that can form parans with fixed stars
+var paranPlanets = []int{
+	SE_SUN, SE_MOON, SE_MERCURY, SE_VENUS, SE_MARS,
+	SE_JUPITER, SE_SATURN, SE_URANUS, SE_NEPTUNE, SE_PLUTO,
+}
+
+// diurnalEvent is a rise, set or transit at an exact Julian Day
+type diurnalEvent struct {
+	name      string
+	julianDay float64
+}
+
+// CalculateStarPositions calculates the ecliptic positions of fixed stars for a Julian Day (UT)
+func (fc *FixedStarCalculator) CalculateStarPositions(julianDay float64, stars []string) ([]domain.FixedStar, error) {
+	var positions []domain.FixedStar
+
+	for _, star := range stars {
+		pos, err := fc.ephemeris.CalculateFixedStar(julianDay, star)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, domain.NewFixedStar(pos.CatalogName, pos.Longitude, pos.Latitude, pos.Magnitude))
+	}
+
+	return positions, nil
+}
+
+// FindConjunctions finds fixed stars within orb of natal planets and the four angles
+func (fc *FixedStarCalculator) FindConjunctions(
+	stars []domain.FixedStar,
+	planets []domain.Planet,
+	ascendant, midheaven float64,
+	orb float64,
+) []domain.StarConjunction {
+
+	type point struct {
+		name      string
+		longitude float64
+	}
+
+	var points []point
+	for _, planet := range planets {
+		points = append(points, point{planet.Name, planet.Longitude})
+	}
+	points = append(points,
+		point{"Ascendant", ascendant},
+		point{"Midheaven", midheaven},
+		point{"Descendant", normalizeAngle360(ascendant + 180)},
+		point{"IC", normalizeAngle360(midheaven + 180)},
+	)
+
+	var conjunctions []domain.StarConjunction
+	for _, star := range stars {
+		for _, p := range points {
+			distance := domain.AngularDistance(star.Longitude, p.longitude)
+			if distance <= orb {
+				conjunctions = append(conjunctions, domain.StarConjunction{
+					Star:  star.Name,
+					Point: p.name,
+					Orb:   distance,
+				})
+			}
+		}
+	}
+
+	return conjunctions
+}
+
+// FindParans finds stars and planets that rise, set or culminate within orb minutes of each other
+// during the 24 hours starting at dayStart (Julian Day UT)
+func (fc *FixedStarCalculator) FindParans(
+	dayStart float64,
+	stars []domain.FixedStar,
+	location *domain.Location,
+	orbMinutes float64,
+	tz *time.Location,
+) ([]domain.StarParan, error) {
+
+	planetEvents := make(map[string][]diurnalEvent)
+	for _, planetID := range paranPlanets {
+		events, err := fc.dayEvents(dayStart, func(jd float64, flag int) (float64, error) {
+			return fc.ephemeris.CalculateRiseSet(jd, planetID, location, flag)
+		})
+		if err != nil {
+			return nil, err
+		}
+		planetEvents[fc.ephemeris.GetPlanetName(planetID)] = events
+	}
+
+	var parans []domain.StarParan
+	for _, star := range stars {
+		starEvents, err := fc.dayEvents(dayStart, func(jd float64, flag int) (float64, error) {
+			return fc.ephemeris.CalculateStarRiseSet(jd, star.Name, location, flag)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, planetID := range paranPlanets {
+			planet := fc.ephemeris.GetPlanetName(planetID)
+			for _, starEvent := range starEvents {
+				for _, planetEvent := range planetEvents[planet] {
+					minutes := math.Abs(starEvent.julianDay-planetEvent.julianDay) * 1440
+					if minutes <= orbMinutes {
+						parans = append(parans, domain.StarParan{
+							Star:      star.Name,
+							StarEvent: starEvent.name,
+							Planet:    planet,
+							Event:     planetEvent.name,
+							Time:      domain.JulianDayToTime(starEvent.julianDay).In(tz),
+							Minutes:   minutes,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return parans, nil
+}
+
+// FindAngularStars finds stars rising, setting or on the meridian within orb minutes of the birth moment
+func (fc *FixedStarCalculator) FindAngularStars(
+	julianDay float64,
+	stars []domain.FixedStar,
+	location *domain.Location,
+	orbMinutes float64,
+	tz *time.Location,
+) ([]domain.AngularStar, error) {
+
+	var angular []domain.AngularStar
+	for _, star := range stars {
+		// Search from just before the birth so events slightly earlier are found too
+		searchFrom := julianDay - orbMinutes/1440
+		for _, event := range diurnalEvents {
+			eventJD, err := fc.ephemeris.CalculateStarRiseSet(searchFrom, star.Name, location, event.flag)
+			if err != nil {
+				// Circumpolar stars never rise or set
+				continue
+			}
+
+			minutes := math.Abs(eventJD-julianDay) * 1440
+			if minutes <= orbMinutes {
+				angular = append(angular, domain.AngularStar{
+					Star:    star.Name,
+					Event:   event.name,
+					Time:    domain.JulianDayToTime(eventJD).In(tz),
+					Minutes: minutes,
+				})
+			}
+		}
+	}
+
+	return angular, nil
+}
+
+// dayEvents collects the rise, set and transit events of a body within 24 hours of dayStart
+func (fc *FixedStarCalculator) dayEvents(
+	dayStart float64,
+	next func(julianDay float64, flag int) (float64, error),
+) ([]diurnalEvent, error) {
+
+	var events []diurnalEvent
+	for _, event := range diurnalEvents {
+		jd, err := next(dayStart, event.flag)
+		if err != nil {
+			// Circumpolar bodies have transits but no rise or set
+			if event.flag == SE_CALC_RISE || event.flag == SE_CALC_SET {
+				continue
+			}
+			return nil, fmt.Errorf("failed to calculate %s: %w", event.name, err)
+		}
+		if jd < dayStart+1 {
+			events = append(events, diurnalEvent{name: event.name, julianDay: jd})
+		}
+	}
+
+	return events, nil
+}
@@ -270,6 +270,31 @@ func (g *GeocodingService) GetCityInfo(cityName string) (*domain.Location, error
 	), nil
 }
 
+// GetCitiesByPopulation returns up to limit cities with at least minPopulation inhabitants, largest first
+func (g *GeocodingService) GetCitiesByPopulation(minPopulation, limit int) ([]domain.Location, error) {
+	rows, err := g.db.Query(
+		`SELECT name, country, latitude, longitude, timezone
+		 FROM cities WHERE population >= ? ORDER BY population DESC LIMIT ?`,
+		minPopulation, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cities: %w", err)
+	}
+	defer rows.Close()
+
+	var cities []domain.Location
+	for rows.Next() {
+		var name, country, timezone string
+		var latitude, longitude float64
+		if err := rows.Scan(&name, &country, &latitude, &longitude, &timezone); err != nil {
+			return nil, fmt.Errorf("failed to read city: %w", err)
+		}
+		cities = append(cities, *domain.NewLocation(name, name, country, latitude, longitude, timezone))
+	}
+
+	return cities, rows.Err()
+}
+
 // Close closes the database connection
 func (g *GeocodingService) Close() error {
 	if g.db != nil {
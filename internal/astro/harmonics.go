@@ -0,0 +1,140 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Harmonic spectrum settings
+const (
+	DefaultSpectrumHarmonics       = 32  // Harmonics included in the spectrum summary
+	harmonicSpectrumConjunctionOrb = 8.0 // Conjunction orb in the harmonic chart
+	harmonicSpectrumStrongest      = 5   // Harmonics listed as strongest
+)
+
+// HarmonicCalculator handles harmonic charts and harmonic spectra
+type HarmonicCalculator struct {
+	aspectCalculator *AspectCalculator
+}
+
+// NewHarmonicCalculator creates a new harmonic calculator
+func NewHarmonicCalculator() *HarmonicCalculator {
+	return &HarmonicCalculator{
+		aspectCalculator: NewAspectCalculator(),
+	}
+}
+
+// CalculateHarmonicChart multiplies every natal longitude (planets and angles) by the harmonic,
+// recalculates aspects and patterns and returns the result as a regular chart.
+// Harmonic charts use equal houses from the harmonic Ascendant.
+func (hc *HarmonicCalculator) CalculateHarmonicChart(natal *domain.Chart, harmonic float64) *domain.Chart {
+	harmonicChart := domain.NewChart(
+		domain.ChartTypeHarmonic,
+		fmt.Sprintf("H%g: %s", math.Round(harmonic*100)/100, natal.Name),
+		natal.BirthInfo,
+	)
+	harmonicChart.HouseSystem = string(domain.HouseEqual)
+	harmonicChart.Timezone = natal.Timezone
+	harmonicChart.UTCTime = natal.UTCTime
+
+	ascendant := domain.HarmonicLongitude(natal.Angles.Ascendant.Value, harmonic)
+	midheaven := domain.HarmonicLongitude(natal.Angles.Midheaven.Value, harmonic)
+	harmonicChart.SetAngles(ascendant, midheaven)
+
+	for i := 0; i < 12; i++ {
+		harmonicChart.AddHouse(domain.NewHouse(i+1, normalizeAngle360(ascendant+float64(i)*30)))
+	}
+
+	var planets []domain.Planet
+	for _, planet := range natal.Planets {
+		longitude := domain.HarmonicLongitude(planet.Longitude, harmonic)
+		house := int(normalizeAngle360(longitude-ascendant)/30) + 1
+		planets = append(planets, domain.NewPlanet(
+			planet.Name,
+			longitude,
+			planet.Latitude,
+			planet.Speed*harmonic,
+			house,
+		))
+	}
+	for _, planet := range planets {
+		harmonicChart.AddPlanet(planet)
+	}
+
+	for _, aspect := range hc.aspectCalculator.CalculateAspects(planets) {
+		harmonicChart.AddAspect(aspect)
+	}
+	harmonicChart.Patterns = hc.aspectCalculator.CalculateAspectPatterns(harmonicChart.Aspects, planets)
+
+	return harmonicChart
+}
+
+// CalculateSpectrum measures harmonics 1 to maxHarmonic of a natal chart.
+// The amplitude is the length of the mean vector of all positions multiplied by the harmonic:
+// 1 when every point falls together in the harmonic chart, near 0 when they are scattered.
+func (hc *HarmonicCalculator) CalculateSpectrum(natal *domain.Chart, maxHarmonic int) *domain.HarmonicSpectrum {
+	longitudes := []float64{natal.Angles.Ascendant.Value, natal.Angles.Midheaven.Value}
+	for _, planet := range natal.Planets {
+		longitudes = append(longitudes, planet.Longitude)
+	}
+
+	spectrum := &domain.HarmonicSpectrum{}
+	for harmonic := 1; harmonic <= maxHarmonic; harmonic++ {
+		var sumX, sumY float64
+		var positions []float64
+		for _, longitude := range longitudes {
+			position := domain.HarmonicLongitude(longitude, float64(harmonic))
+			positions = append(positions, position)
+			sumX += math.Cos(position * math.Pi / 180)
+			sumY += math.Sin(position * math.Pi / 180)
+		}
+
+		conjunctions := 0
+		for i := 0; i < len(positions); i++ {
+			for j := i + 1; j < len(positions); j++ {
+				if domain.AngularDistance(positions[i], positions[j]) <= harmonicSpectrumConjunctionOrb {
+					conjunctions++
+				}
+			}
+		}
+
+		spectrum.Harmonics = append(spectrum.Harmonics, domain.HarmonicStrength{
+			Harmonic:     harmonic,
+			Amplitude:    math.Hypot(sumX, sumY) / float64(len(longitudes)),
+			Conjunctions: conjunctions,
+		})
+	}
+
+	// The first harmonic is the natal chart itself and is left out of the ranking
+	ranked := append([]domain.HarmonicStrength(nil), spectrum.Harmonics...)
+	if len(ranked) > 0 {
+		ranked = ranked[1:]
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Amplitude > ranked[j].Amplitude
+	})
+	for i := 0; i < len(ranked) && i < harmonicSpectrumStrongest; i++ {
+		spectrum.Strongest = append(spectrum.Strongest, ranked[i].Harmonic)
+	}
+
+	return spectrum
+}
+
+// CalculateAgeHarmonic returns the age in years (with fraction) at the given moment
+func (hc *HarmonicCalculator) CalculateAgeHarmonic(natal *domain.Chart, at time.Time) (float64, error) {
+	if natal.UTCTime.IsZero() {
+		return 0, fmt.Errorf("natal chart has no birth time")
+	}
+	if at.Before(natal.UTCTime) {
+		return 0, fmt.Errorf("age date must be after the birth date")
+	}
+
+	age := at.Sub(natal.UTCTime).Hours() / 24 / 365.2425
+	if age < 1 {
+		return 0, fmt.Errorf("age harmonic must be at least 1 (age %.2f years)", age)
+	}
+	return age, nil
+}
@@ -2,6 +2,7 @@ package astro
 
 import (
 	"astroeph-api/internal/domain"
+	"fmt"
 	"math"
 )
 
@@ -23,12 +24,25 @@ func (hc *HouseCalculator) CalculateHouses(
 	location *domain.Location,
 	houseSystem domain.HouseSystem,
 ) ([]domain.House, error) {
+	houses, _, err := hc.calculateHousesData(timeInfo, location, houseSystem)
+	return houses, err
+}
+
+// calculateHousesData calculates the houses and keeps the angles and sensitive points of the ephemeris
+func (hc *HouseCalculator) calculateHousesData(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	houseSystem domain.HouseSystem,
+) ([]domain.House, *HousesData, error) {
 
 	// Convert to Julian Day
 	julianDay := hc.ephemeris.GetJulianDay(timeInfo)
 
 	// Get house system code
-	systemCode := hc.ephemeris.GetHouseSystemCode(string(houseSystem))
+	systemCode, err := hc.ephemeris.GetHouseSystemCode(string(houseSystem))
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// Calculate houses using ephemeris
 	housesData, err := hc.ephemeris.CalculateHouses(
@@ -38,8 +52,9 @@ func (hc *HouseCalculator) CalculateHouses(
 		systemCode,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	housesData.HouseSystem = houseSystem
 
 	// Convert to domain houses
 	houses := make([]domain.House, 12)
@@ -48,10 +63,117 @@ func (hc *HouseCalculator) CalculateHouses(
 	for i := 0; i < 12; i++ {
 		house := domain.NewHouse(i+1, housesData.Cusps[i])
 		house.Size = houseSizes[i]
+		house.CuspSpeed = housesData.CuspSpeeds[i]
 		houses[i] = house
 	}
 
-	return houses, nil
+	return houses, housesData, nil
+}
+
+// CalculateHousePositions places the planets in houses from their ecliptic longitude and
+// latitude, so that planets far from the ecliptic fall in the house they occupy on the sphere.
+// It sets the fractional HousePosition and the House number, and the Gauquelin sector when
+// requested. The ARMC and the house system are those of the houses already calculated.
+// Points the system cannot place keep their ecliptic house, and a warning is returned for each.
+func (hc *HouseCalculator) CalculateHousePositions(
+	planets []domain.Planet,
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	housesData *HousesData,
+	includeGauquelin bool,
+) ([]string, error) {
+
+	const SE_HOUSE_GAUQUELIN = 'G'
+
+	systemCode, err := hc.ephemeris.GetHouseSystemCode(string(housesData.HouseSystem))
+	if err != nil {
+		return nil, err
+	}
+
+	obliquity, err := hc.ephemeris.GetObliquity(hc.ephemeris.GetJulianDay(timeInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	for i := range planets {
+		planet := &planets[i]
+		position, err := hc.ephemeris.CalculateHousePosition(
+			housesData.ARMC, location.Latitude, obliquity, systemCode, planet.Longitude, planet.Latitude)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s could not be placed in %s houses by latitude; its ecliptic house is kept (%v)",
+				planet.Name, housesData.HouseSystem, err))
+		} else {
+			planet.HousePosition = position
+			planet.House = int(position)
+		}
+
+		if includeGauquelin {
+			sector, err := hc.ephemeris.CalculateHousePosition(
+				housesData.ARMC, location.Latitude, obliquity, SE_HOUSE_GAUQUELIN, planet.Longitude, planet.Latitude)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf(
+					"no Gauquelin sector for %s (%v)", planet.Name, err))
+			} else {
+				planet.GauquelinSector = sector
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// CalculateHousesWithFallback calculates houses in the requested system and, when a
+// quadrant system cannot be drawn inside the polar circles, in the fallback system
+// instead. The houses data holds the system actually used, the ARMC and the sensitive points.
+func (hc *HouseCalculator) CalculateHousesWithFallback(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	houseSystem domain.HouseSystem,
+	fallback domain.HouseSystem,
+) ([]domain.House, *HousesData, error) {
+
+	houses, housesData, err := hc.calculateHousesData(timeInfo, location, houseSystem)
+	if err == nil {
+		return houses, housesData, nil
+	}
+
+	polar, polarErr := hc.IsPolarLatitude(timeInfo, location.Latitude)
+	if polarErr != nil {
+		return nil, nil, polarErr
+	}
+	if !polar || houseSystem == fallback {
+		return nil, nil, err
+	}
+
+	return hc.calculateHousesData(timeInfo, location, fallback)
+}
+
+// GetPolarFallbackWarning describes the fallback applied to the requested house system,
+// or returns "" when the requested system was used
+func GetPolarFallbackWarning(requested domain.HouseSystem, housesData *HousesData, latitude float64) string {
+	if housesData.HouseSystem == requested {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s houses are undefined at latitude %.2f° inside the polar circle; %s houses were used instead",
+		requested, latitude, housesData.HouseSystem)
+}
+
+// IsPolarLatitude returns true when the latitude is inside a polar circle at the given
+// time, where some ecliptic degrees never rise and quadrant systems break down
+func (hc *HouseCalculator) IsPolarLatitude(timeInfo *domain.TimeInfo, latitude float64) (bool, error) {
+	obliquity, err := hc.ephemeris.GetObliquity(hc.ephemeris.GetJulianDay(timeInfo))
+	if err != nil {
+		return false, err
+	}
+	return math.Abs(latitude) >= 90-obliquity, nil
+}
+
+// IsValidPolarFallback checks if a house system can replace a quadrant system inside the polar circles
+func IsValidPolarFallback(system string) bool {
+	return system == string(domain.HousePorphyrius) || system == string(domain.HouseWholeSign)
 }
 
 // DetermineHouseForPlanet determines which house a planet is in
@@ -148,6 +270,28 @@ func GetHouseSystemName(system domain.HouseSystem) string {
 		return "Equal"
 	case domain.HouseWholeSign:
 		return "Whole Sign"
+	case domain.HouseAlcabitius:
+		return "Alcabitius"
+	case domain.HouseMorinus:
+		return "Morinus"
+	case domain.HouseTopocentric:
+		return "Topocentric"
+	case domain.HouseMeridian:
+		return "Meridian"
+	case domain.HouseVehlow:
+		return "Vehlow"
+	case domain.HouseSripati:
+		return "Sripati"
+	case domain.HouseKrusinski:
+		return "Krusinski"
+	case domain.HouseAPC:
+		return "APC"
+	case domain.HouseHorizontal:
+		return "Horizontal"
+	case domain.HousePolichPage:
+		return "Polich-Page"
+	case domain.HouseSunshine:
+		return "Sunshine"
 	default:
 		return "Placidus"
 	}
@@ -163,6 +307,17 @@ func GetAvailableHouseSystems() []domain.HouseSystem {
 		domain.HouseCampanus,
 		domain.HouseEqual,
 		domain.HouseWholeSign,
+		domain.HouseAlcabitius,
+		domain.HouseMorinus,
+		domain.HouseTopocentric,
+		domain.HouseMeridian,
+		domain.HouseVehlow,
+		domain.HouseSripati,
+		domain.HouseKrusinski,
+		domain.HouseAPC,
+		domain.HouseHorizontal,
+		domain.HousePolichPage,
+		domain.HouseSunshine,
 	}
 }
 
@@ -176,6 +331,17 @@ func IsValidHouseSystem(system string) bool {
 		"Campanus":      true,
 		"Equal":         true,
 		"Whole Sign":    true,
+		"Alcabitius":    true,
+		"Morinus":       true,
+		"Topocentric":   true,
+		"Meridian":      true,
+		"Vehlow":        true,
+		"Sripati":       true,
+		"Krusinski":     true,
+		"APC":           true,
+		"Horizontal":    true,
+		"Polich-Page":   true,
+		"Sunshine":      true,
 	}
 
 	return validSystems[system]
@@ -0,0 +1,118 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+)
+
+// KPAyanamsa is the ayanamsa used by Krishnamurti Paddhati
+const KPAyanamsa = "Krishnamurti"
+
+// KPCalculator handles Krishnamurti Paddhati (KP) calculations
+type KPCalculator struct {
+	ephemeris       *Ephemeris
+	houseCalculator *HouseCalculator
+	vedicCalculator *VedicCalculator
+}
+
+// NewKPCalculator creates a new KP calculator
+func NewKPCalculator(ephemeris *Ephemeris) *KPCalculator {
+	return &KPCalculator{
+		ephemeris:       ephemeris,
+		houseCalculator: NewHouseCalculator(ephemeris),
+		vedicCalculator: NewVedicCalculator(ephemeris),
+	}
+}
+
+// CalculateKPChart calculates sidereal Placidus cusps, planet lords and house significators
+func (kc *KPCalculator) CalculateKPChart(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+) (*domain.KPChart, error) {
+
+	positions, err := kc.vedicCalculator.CalculateSiderealPositions(timeInfo, location, KPAyanamsa)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate sidereal positions: %w", err)
+	}
+
+	// KP uses tropical Placidus cusps shifted by the KP ayanamsa
+	houses, housesData, err := kc.houseCalculator.CalculateHousesWithFallback(
+		timeInfo, location, domain.HousePlacidus, domain.HousePorphyrius)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate houses: %w", err)
+	}
+
+	chart := &domain.KPChart{
+		Ayanamsa:      positions.Ayanamsa,
+		AyanamsaValue: positions.AyanamsaValue,
+	}
+	if warning := GetPolarFallbackWarning(domain.HousePlacidus, housesData, location.Latitude); warning != "" {
+		chart.Warnings = append(chart.Warnings, warning)
+	}
+
+	cusps := make([]float64, 12)
+	for i, house := range houses {
+		longitude := normalizeAngle360(house.CuspValue - positions.AyanamsaValue)
+		cusps[i] = longitude
+
+		chart.Cusps = append(chart.Cusps, domain.KPCusp{
+			House:     house.Number,
+			Longitude: longitude,
+			Sign:      domain.GetZodiacSign(longitude),
+			Degree:    domain.FormatDegreeInSign(longitude),
+			Nakshatra: domain.GetNakshatraName(domain.GetNakshatraIndex(longitude)),
+			KPLords:   domain.GetKPLords(longitude),
+		})
+	}
+
+	for _, planet := range positions.Planets {
+		chart.Planets = append(chart.Planets, domain.KPPlanet{
+			Name:         planet.Name,
+			Longitude:    planet.Longitude,
+			Sign:         planet.Sign,
+			Degree:       planet.Degree,
+			House:        kc.houseCalculator.DetermineHouseForPlanet(planet.Longitude, cusps),
+			IsRetrograde: planet.IsRetrograde,
+			Nakshatra:    domain.GetNakshatraName(domain.GetNakshatraIndex(planet.Longitude)),
+			KPLords:      domain.GetKPLords(planet.Longitude),
+		})
+	}
+
+	chart.Significators = kc.calculateSignificators(chart)
+
+	return chart, nil
+}
+
+// calculateSignificators lists the four levels of significators for each house
+func (kc *KPCalculator) calculateSignificators(chart *domain.KPChart) []domain.KPSignificators {
+	// Planets whose star lord is the given planet
+	inStarOf := func(lord string) []string {
+		var planets []string
+		for _, planet := range chart.Planets {
+			if planet.StarLord == lord {
+				planets = append(planets, planet.Name)
+			}
+		}
+		return planets
+	}
+
+	var significators []domain.KPSignificators
+	for _, cusp := range chart.Cusps {
+		sig := domain.KPSignificators{
+			House:  cusp.House,
+			Level4: []string{cusp.SignLord},
+			Level3: inStarOf(cusp.SignLord),
+		}
+
+		for _, planet := range chart.Planets {
+			if planet.House == cusp.House {
+				sig.Level2 = append(sig.Level2, planet.Name)
+				sig.Level1 = append(sig.Level1, inStarOf(planet.Name)...)
+			}
+		}
+
+		significators = append(significators, sig)
+	}
+
+	return significators
+}
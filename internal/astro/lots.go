@@ -0,0 +1,152 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"strings"
+)
+
+// LotCalculator handles Arabic parts / Hellenistic lots
+type LotCalculator struct {
+	houseCalculator *HouseCalculator
+}
+
+// NewLotCalculator creates a new lot calculator
+func NewLotCalculator(ephemeris *Ephemeris) *LotCalculator {
+	return &LotCalculator{
+		houseCalculator: NewHouseCalculator(ephemeris),
+	}
+}
+
+// CalculateLots calculates the standard lots plus any custom lots.
+// Formulas are given for day charts and reversed when the Sun is below the horizon.
+// The daily motion of each lot follows from the motion of the points in its formula.
+func (lc *LotCalculator) CalculateLots(
+	planets []domain.Planet,
+	houses []domain.House,
+	angles domain.ChartAngles,
+	includeStandard bool,
+	custom []domain.LotDefinition,
+) ([]domain.Lot, error) {
+
+	var definitions []domain.LotDefinition
+	if includeStandard {
+		definitions = append(definitions, domain.GetStandardLots()...)
+	}
+	definitions = append(definitions, custom...)
+
+	ascendant := angles.Ascendant.Value
+	midheaven := angles.Midheaven.Value
+
+	// Points that can be referenced in formulas, keyed by lowercase name
+	points := map[string]float64{
+		"asc":        ascendant,
+		"ascendant":  ascendant,
+		"mc":         midheaven,
+		"midheaven":  midheaven,
+		"dsc":        normalizeAngle360(ascendant + 180),
+		"descendant": normalizeAngle360(ascendant + 180),
+		"ic":         normalizeAngle360(midheaven + 180),
+	}
+	speeds := map[string]float64{
+		"asc":        angles.Ascendant.Speed,
+		"ascendant":  angles.Ascendant.Speed,
+		"mc":         angles.Midheaven.Speed,
+		"midheaven":  angles.Midheaven.Speed,
+		"dsc":        angles.Ascendant.Speed,
+		"descendant": angles.Ascendant.Speed,
+		"ic":         angles.Midheaven.Speed,
+	}
+	for _, planet := range planets {
+		points[strings.ToLower(planet.Name)] = planet.Longitude
+		speeds[strings.ToLower(planet.Name)] = planet.Speed
+	}
+	houseCusps := make([]float64, len(houses))
+	for i, house := range houses {
+		houseCusps[i] = house.CuspValue
+		points[fmt.Sprintf("h%d", house.Number)] = house.CuspValue
+		speeds[fmt.Sprintf("h%d", house.Number)] = house.CuspSpeed
+	}
+
+	isNight := IsNightChart(planets, ascendant)
+
+	var lots []domain.Lot
+	for _, definition := range definitions {
+		terms, err := domain.ParseLotFormula(definition.Formula)
+		if err != nil {
+			return nil, err
+		}
+		if isNight {
+			terms = domain.ReverseLotTerms(terms)
+		}
+
+		longitude := 0.0
+		speed := 0.0
+		for _, term := range terms {
+			key := strings.ToLower(term.Point)
+			value, exists := points[key]
+			if !exists {
+				// Lots may reference earlier lots by their short name, e.g. "Fortune"
+				key = strings.ToLower(domain.GetLotName(term.Point))
+				value, exists = points[key]
+			}
+			if !exists {
+				return nil, fmt.Errorf("unknown point %q in lot %s", term.Point, definition.Name)
+			}
+			longitude += float64(term.Sign) * value
+			speed += float64(term.Sign) * speeds[key]
+		}
+		longitude = normalizeAngle360(longitude)
+
+		lot := domain.Lot{
+			Name:       domain.GetLotName(definition.Name),
+			Formula:    domain.FormatLotFormula(terms),
+			Longitude:  longitude,
+			Speed:      speed,
+			Sign:       domain.GetZodiacSign(longitude),
+			Degree:     domain.FormatDegreeInSign(longitude),
+			House:      lc.houseCalculator.DetermineHouseForPlanet(longitude, houseCusps),
+			IsReversed: isNight,
+		}
+		points[strings.ToLower(lot.Name)] = longitude
+		speeds[strings.ToLower(lot.Name)] = speed
+		lots = append(lots, lot)
+	}
+
+	return lots, nil
+}
+
+// ValidateLotPoints checks that every point named in the custom lot formulas is an angle,
+// a house cusp (H1 to H12), one of the given planets or a lot defined before it
+func ValidateLotPoints(custom []domain.LotDefinition, planetNames []string, includeStandard bool) error {
+	known := map[string]bool{
+		"asc": true, "ascendant": true, "mc": true, "midheaven": true,
+		"dsc": true, "descendant": true, "ic": true,
+	}
+	for house := 1; house <= 12; house++ {
+		known[fmt.Sprintf("h%d", house)] = true
+	}
+	for _, name := range planetNames {
+		known[strings.ToLower(name)] = true
+	}
+	if includeStandard {
+		for _, definition := range domain.GetStandardLots() {
+			known[strings.ToLower(domain.GetLotName(definition.Name))] = true
+		}
+	}
+
+	for _, definition := range custom {
+		terms, err := domain.ParseLotFormula(definition.Formula)
+		if err != nil {
+			return err
+		}
+		for _, term := range terms {
+			if !known[strings.ToLower(term.Point)] && !known[strings.ToLower(domain.GetLotName(term.Point))] {
+				return fmt.Errorf("unknown point %q in lot %s", term.Point, definition.Name)
+			}
+		}
+		known[strings.ToLower(domain.GetLotName(definition.Name))] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"sort"
+)
+
+// DefaultMidpointOrb is the default dial orb in degrees for midpoint contacts
+const DefaultMidpointOrb = 1.5
+
+// MidpointCalculator handles midpoints, midpoint trees and planetary pictures
+type MidpointCalculator struct{}
+
+// NewMidpointCalculator creates a new midpoint calculator
+func NewMidpointCalculator() *MidpointCalculator {
+	return &MidpointCalculator{}
+}
+
+// chartPoint is a named longitude used in midpoint work (planets and angles)
+type chartPoint struct {
+	name      string
+	longitude float64
+}
+
+// CalculateMidpointAnalysis calculates every planet/angle midpoint, the midpoint trees
+// of each point and the planetary pictures A + B - C = D on the given dial
+func (mc *MidpointCalculator) CalculateMidpointAnalysis(
+	planets []domain.Planet,
+	ascendant, midheaven float64,
+	dial, orb float64,
+) *domain.MidpointAnalysis {
+
+	var points []chartPoint
+	for _, planet := range planets {
+		points = append(points, chartPoint{planet.Name, planet.Longitude})
+	}
+	points = append(points,
+		chartPoint{"Ascendant", ascendant},
+		chartPoint{"Midheaven", midheaven},
+	)
+
+	midpoints := mc.calculateMidpoints(points, dial)
+
+	return &domain.MidpointAnalysis{
+		Dial:      dial,
+		Orb:       orb,
+		Midpoints: midpoints,
+		Trees:     mc.calculateTrees(points, midpoints, dial, orb),
+		Pictures:  mc.calculatePictures(points, dial, orb),
+	}
+}
+
+// calculateMidpoints calculates the midpoint of every pair of points
+func (mc *MidpointCalculator) calculateMidpoints(points []chartPoint, dial float64) []domain.Midpoint {
+	var midpoints []domain.Midpoint
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			longitude := domain.CalculateMidpoint(points[i].longitude, points[j].longitude)
+			midpoints = append(midpoints, domain.Midpoint{
+				Name:         points[i].name + "/" + points[j].name,
+				Point1:       points[i].name,
+				Point2:       points[j].name,
+				Longitude:    longitude,
+				Sign:         domain.GetZodiacSign(longitude),
+				Degree:       domain.FormatDegreeInSign(longitude),
+				DialPosition: domain.DialPosition(longitude, dial),
+			})
+		}
+	}
+	return midpoints
+}
+
+// calculateTrees lists, for each point, the midpoints within orb of it on the dial
+func (mc *MidpointCalculator) calculateTrees(
+	points []chartPoint,
+	midpoints []domain.Midpoint,
+	dial, orb float64,
+) []domain.MidpointTree {
+
+	var trees []domain.MidpointTree
+	for _, point := range points {
+		tree := domain.MidpointTree{
+			Point:        point.name,
+			Longitude:    point.longitude,
+			DialPosition: domain.DialPosition(point.longitude, dial),
+			Midpoints:    []domain.MidpointContact{},
+		}
+
+		for _, midpoint := range midpoints {
+			// A point always sits on the axis of its own midpoints with conjunct/opposite partners
+			if midpoint.Point1 == point.name || midpoint.Point2 == point.name {
+				continue
+			}
+
+			distance := domain.DialDistance(point.longitude, midpoint.Longitude, dial)
+			if distance <= orb {
+				tree.Midpoints = append(tree.Midpoints, domain.MidpointContact{
+					Midpoint: midpoint.Name,
+					Orb:      distance,
+				})
+			}
+		}
+
+		sort.Slice(tree.Midpoints, func(i, j int) bool {
+			return tree.Midpoints[i].Orb < tree.Midpoints[j].Orb
+		})
+		trees = append(trees, tree)
+	}
+	return trees
+}
+
+// calculatePictures finds the planetary pictures A + B - C = D within orb on the dial.
+// Each picture is reported once even though it can be written in several ways.
+func (mc *MidpointCalculator) calculatePictures(points []chartPoint, dial, orb float64) []domain.PlanetaryPicture {
+	var pictures []domain.PlanetaryPicture
+	seen := make(map[string]bool)
+
+	for a := 0; a < len(points); a++ {
+		for b := a + 1; b < len(points); b++ {
+			for c := range points {
+				if c == a || c == b {
+					continue
+				}
+
+				sensitivePoint := normalizeAngle360(points[a].longitude + points[b].longitude - points[c].longitude)
+
+				for d := range points {
+					if d == a || d == b || d == c {
+						continue
+					}
+
+					distance := domain.DialDistance(sensitivePoint, points[d].longitude, dial)
+					if distance > orb {
+						continue
+					}
+
+					// A + B - C = D is the same picture as A/B = C/D
+					key := pictureKey(a, b, c, d)
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+
+					pictures = append(pictures, domain.PlanetaryPicture{
+						Formula: fmt.Sprintf("%s + %s - %s = %s",
+							points[a].name, points[b].name, points[c].name, points[d].name),
+						PointA:         points[a].name,
+						PointB:         points[b].name,
+						PointC:         points[c].name,
+						PointD:         points[d].name,
+						SensitivePoint: sensitivePoint,
+						Sign:           domain.GetZodiacSign(sensitivePoint),
+						Degree:         domain.FormatDegreeInSign(sensitivePoint),
+						Orb:            distance,
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(pictures, func(i, j int) bool {
+		return pictures[i].Orb < pictures[j].Orb
+	})
+	return pictures
+}
+
+// pictureKey identifies the midpoint equation A/B = C/D regardless of term order
+func pictureKey(a, b, c, d int) string {
+	pair1 := [2]int{a, b}
+	pair2 := [2]int{c, d}
+	if pair2[0] > pair2[1] {
+		pair2[0], pair2[1] = pair2[1], pair2[0]
+	}
+	if pair2[0] < pair1[0] || (pair2[0] == pair1[0] && pair2[1] < pair1[1]) {
+		pair1, pair2 = pair2, pair1
+	}
+	return fmt.Sprintf("%d/%d=%d/%d", pair1[0], pair1[1], pair2[0], pair2[1])
+}
@@ -0,0 +1,295 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"sort"
+)
+
+// Thresholds in degrees for the Jones shapes
+const (
+	bundleSpan         = 120.0 // maximum occupied arc of a bundle
+	bowlSpan           = 180.0 // maximum occupied arc of a bowl
+	locomotiveSpan     = 240.0 // maximum occupied arc of a locomotive
+	emptyAreaMinimum   = 60.0  // minimum empty arc around a bucket handle or between seesaw groups
+	splayGapMinimum    = 40.0  // minimum gap separating splay groups
+	hemisphereMargin   = 1.25  // ratio over the opposite hemisphere needed for emphasis
+	maximumPointWeight = 10.0  // maximum weight of a point in the balances
+)
+
+// OverviewCalculator handles chart shape, hemisphere emphasis and sign balances
+type OverviewCalculator struct{}
+
+// NewOverviewCalculator creates a new overview calculator
+func NewOverviewCalculator() *OverviewCalculator {
+	return &OverviewCalculator{}
+}
+
+// ValidatePointWeights checks the point weights used for the balances
+func ValidatePointWeights(weights map[string]float64) error {
+	defaults := domain.GetDefaultPointWeights()
+	for point, weight := range weights {
+		if _, exists := defaults[point]; !exists {
+			return fmt.Errorf("unknown point in point_weights: %s", point)
+		}
+		if weight < 0 || weight > maximumPointWeight {
+			return fmt.Errorf("point weight for %s must be between 0 and %.0f", point, maximumPointWeight)
+		}
+	}
+	return nil
+}
+
+// CalculateOverview classifies the chart shape from the ten planets, weighs the hemispheres
+// and the element, modality and polarity balances, and describes the chart ruler.
+// weights override the default point weights; a weight of 0 leaves a point out.
+func (oc *OverviewCalculator) CalculateOverview(
+	planets []domain.Planet,
+	ascendant, midheaven float64,
+	weights map[string]float64,
+	settings domain.DignitySettings,
+	isNight bool,
+) *domain.ChartOverview {
+
+	pointWeights := domain.GetDefaultPointWeights()
+	for point, weight := range weights {
+		pointWeights[point] = weight
+	}
+
+	overview := &domain.ChartOverview{
+		PointWeights: pointWeights,
+	}
+
+	// The shape only considers the ten planets
+	var shapePlanets []domain.Planet
+	for _, planet := range planets {
+		if domain.GetMeanDailyMotion(planet.Name) > 0 {
+			shapePlanets = append(shapePlanets, planet)
+		}
+	}
+	overview.Shape, overview.ShapeLeader = oc.classifyShape(shapePlanets)
+
+	// Weighted planets; the Ascendant and Midheaven also count in the sign balances
+	type weightedPoint struct {
+		longitude float64
+		weight    float64
+	}
+	var points []weightedPoint
+	for _, planet := range planets {
+		if weight := pointWeights[planet.Name]; weight > 0 {
+			points = append(points, weightedPoint{planet.Longitude, weight})
+		}
+	}
+
+	elements := make(map[string]float64)
+	modalities := make(map[string]float64)
+	polarities := make(map[string]float64)
+	for _, point := range points {
+		oc.addToHemispheres(&overview.Hemispheres, point.longitude, ascendant, midheaven, point.weight)
+	}
+	for _, point := range append(points,
+		weightedPoint{ascendant, pointWeights[domain.PointAscendant]},
+		weightedPoint{midheaven, pointWeights[domain.PointMidheaven]},
+	) {
+		sign := domain.GetZodiacSign(point.longitude)
+		elements[domain.GetElementForSign(sign)] += point.weight
+		modalities[domain.GetModalityForSign(sign)] += point.weight
+		polarities[domain.GetPolarityForSign(sign)] += point.weight
+	}
+	overview.Hemispheres.Emphasis = oc.getHemisphereEmphasis(overview.Hemispheres)
+	overview.Elements = oc.newBalance(elements, []string{"fire", "earth", "air", "water"})
+	overview.Modalities = oc.newBalance(modalities, []string{"cardinal", "fixed", "mutable"})
+	overview.Polarities = oc.newBalance(polarities, []string{"positive", "negative"})
+
+	overview.ChartRuler = oc.getChartRuler(planets, ascendant, settings, isNight)
+
+	return overview
+}
+
+// IsNightChart returns true when the Sun is below the horizon, between the Ascendant and the
+// Descendant in zodiacal order. Lots, sect and the chart overview all use this one test.
+func IsNightChart(planets []domain.Planet, ascendant float64) bool {
+	for _, planet := range planets {
+		if planet.Name == string(domain.Sun) {
+			return normalizeAngle360(planet.Longitude-ascendant) < 180
+		}
+	}
+	return false
+}
+
+// classifyShape returns the Jones shape of the planets and its leading or handle planet
+func (oc *OverviewCalculator) classifyShape(planets []domain.Planet) (domain.ChartShape, string) {
+	if len(planets) < 3 {
+		return "", ""
+	}
+
+	sorted := append([]domain.Planet(nil), planets...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Longitude < sorted[j].Longitude
+	})
+
+	// gaps[i] is the empty arc from sorted[i] to the next planet in zodiacal order
+	gaps := make([]float64, len(sorted))
+	largest := 0
+	for i := range sorted {
+		next := sorted[(i+1)%len(sorted)]
+		gaps[i] = normalizeAngle360(next.Longitude - sorted[i].Longitude)
+		if gaps[i] > gaps[largest] {
+			largest = i
+		}
+	}
+	span := 360 - gaps[largest]
+
+	// The leading planet sits at the clockwise edge of the occupied arc,
+	// the first to be carried over the empty area by the diurnal motion
+	leader := sorted[(largest+1)%len(sorted)].Name
+
+	switch {
+	case span <= bundleSpan:
+		return domain.ShapeBundle, leader
+	case span <= bowlSpan:
+		return domain.ShapeBowl, leader
+	}
+
+	// Bucket: every planet but one within half the chart, the handle alone in the empty half
+	for i := range sorted {
+		before := gaps[(i-1+len(sorted))%len(sorted)]
+		after := gaps[i]
+		if before < emptyAreaMinimum || after < emptyAreaMinimum {
+			continue
+		}
+		// Without the handle its two neighbouring gaps merge into one
+		if 360-(before+after) <= bowlSpan {
+			return domain.ShapeBucket, sorted[i].Name
+		}
+	}
+
+	if span <= locomotiveSpan {
+		return domain.ShapeLocomotive, leader
+	}
+
+	wideGaps := 0
+	splayGaps := 0
+	for _, gap := range gaps {
+		if gap >= emptyAreaMinimum {
+			wideGaps++
+		}
+		if gap >= splayGapMinimum {
+			splayGaps++
+		}
+	}
+
+	switch {
+	case wideGaps == 2:
+		return domain.ShapeSeesaw, ""
+	case splayGaps >= 3:
+		return domain.ShapeSplay, ""
+	default:
+		return domain.ShapeSplash, ""
+	}
+}
+
+// addToHemispheres adds a weighted point to its hemispheres and quadrant, using the angles
+func (oc *OverviewCalculator) addToHemispheres(h *domain.HemisphereEmphasis, longitude, ascendant, midheaven, weight float64) {
+	descendant := normalizeAngle360(ascendant + 180)
+	ic := normalizeAngle360(midheaven + 180)
+
+	// Houses 10 to 3 run zodiacally from the Midheaven to the IC through the Ascendant
+	if normalizeAngle360(longitude-midheaven) < normalizeAngle360(ic-midheaven) {
+		h.Eastern += weight
+	} else {
+		h.Western += weight
+	}
+
+	// Houses 7 to 12 run zodiacally from the Descendant to the Ascendant
+	if normalizeAngle360(longitude-descendant) < 180 {
+		h.Southern += weight
+	} else {
+		h.Northern += weight
+	}
+
+	switch {
+	case normalizeAngle360(longitude-ascendant) < normalizeAngle360(ic-ascendant):
+		h.Quadrants[0] += weight
+	case normalizeAngle360(longitude-ic) < normalizeAngle360(descendant-ic):
+		h.Quadrants[1] += weight
+	case normalizeAngle360(longitude-descendant) < normalizeAngle360(midheaven-descendant):
+		h.Quadrants[2] += weight
+	default:
+		h.Quadrants[3] += weight
+	}
+}
+
+// getHemisphereEmphasis names the hemispheres clearly stronger than their opposite
+func (oc *OverviewCalculator) getHemisphereEmphasis(h domain.HemisphereEmphasis) []string {
+	var emphasis []string
+	for _, pair := range []struct {
+		name            string
+		score, opposite float64
+	}{
+		{"eastern", h.Eastern, h.Western},
+		{"western", h.Western, h.Eastern},
+		{"northern", h.Northern, h.Southern},
+		{"southern", h.Southern, h.Northern},
+	} {
+		if pair.score > pair.opposite*hemisphereMargin {
+			emphasis = append(emphasis, pair.name)
+		}
+	}
+	return emphasis
+}
+
+// newBalance finds the dominant and missing qualities of a weighted distribution
+func (oc *OverviewCalculator) newBalance(scores map[string]float64, qualities []string) domain.Balance {
+	balance := domain.Balance{
+		Scores: make(map[string]float64),
+	}
+	for _, quality := range qualities {
+		balance.Scores[quality] = scores[quality]
+		if scores[quality] == 0 {
+			balance.Missing = append(balance.Missing, quality)
+		}
+		if balance.Dominant == "" || scores[quality] > scores[balance.Dominant] {
+			balance.Dominant = quality
+		}
+	}
+	return balance
+}
+
+// getChartRuler returns the ruler of the Ascendant sign and its essential and accidental condition
+func (oc *OverviewCalculator) getChartRuler(
+	planets []domain.Planet,
+	ascendant float64,
+	settings domain.DignitySettings,
+	isNight bool,
+) *domain.ChartRulerCondition {
+
+	ruler := domain.GetSignRuler(domain.GetZodiacSign(ascendant), settings.Rulership)
+
+	sunLongitude, hasSun := 0.0, false
+	for _, planet := range planets {
+		if planet.Name == string(domain.Sun) {
+			sunLongitude, hasSun = planet.Longitude, true
+		}
+	}
+
+	for _, planet := range planets {
+		if planet.Name != ruler {
+			continue
+		}
+
+		condition := &domain.ChartRulerCondition{
+			Planet:       planet.Name,
+			Sign:         planet.Sign,
+			House:        planet.House,
+			IsRetrograde: planet.IsRetrograde,
+			Essential:    domain.NewEssentialDignity(planet.Name, planet.Longitude, isNight, settings),
+		}
+		if hasSun {
+			accidental := domain.NewAccidentalDignity(planet, sunLongitude, isNight)
+			condition.Accidental = &accidental
+		}
+		return condition
+	}
+
+	return nil
+}
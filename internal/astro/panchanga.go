@@ -0,0 +1,225 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"math"
+	"time"
+)
+
+// PanchangaCalculator handles Hindu almanac (panchanga) calculations
+type PanchangaCalculator struct {
+	ephemeris *Ephemeris
+}
+
+// NewPanchangaCalculator creates a new panchanga calculator
+func NewPanchangaCalculator(ephemeris *Ephemeris) *PanchangaCalculator {
+	return &PanchangaCalculator{
+		ephemeris: ephemeris,
+	}
+}
+
+// angleFunc returns an angle in degrees [0, 360) for a Julian Day (UT)
+type angleFunc func(julianDay float64) (float64, error)
+
+// CalculatePanchanga calculates the panchanga for the day starting at the given local midnight
+func (pc *PanchangaCalculator) CalculatePanchanga(
+	dayStart *domain.TimeInfo,
+	location *domain.Location,
+	ayanamsa string,
+) (*domain.Panchanga, error) {
+
+	julianDay := pc.ephemeris.GetJulianDay(dayStart)
+	siderealMode := pc.ephemeris.GetSiderealModeCode(ayanamsa)
+	tz := dayStart.LocalTime.Location()
+
+	sunriseJD, err := pc.ephemeris.CalculateRiseSet(julianDay, SE_SUN, location, SE_CALC_RISE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate sunrise: %w", err)
+	}
+	sunsetJD, err := pc.ephemeris.CalculateRiseSet(sunriseJD, SE_SUN, location, SE_CALC_SET)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate sunset: %w", err)
+	}
+	nextSunriseJD, err := pc.ephemeris.CalculateRiseSet(sunsetJD, SE_SUN, location, SE_CALC_RISE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate next sunrise: %w", err)
+	}
+
+	toLocal := func(jd float64) time.Time {
+		return domain.JulianDayToTime(jd).In(tz)
+	}
+
+	sunrise := toLocal(sunriseJD)
+	sunset := toLocal(sunsetJD)
+
+	panchanga := &domain.Panchanga{
+		Date:        dayStart.FormatDateForDisplay(),
+		Location:    *location,
+		Ayanamsa:    ayanamsa,
+		Sunrise:     sunrise,
+		Sunset:      sunset,
+		NextSunrise: toLocal(nextSunriseJD),
+		Vara:        domain.GetVara(sunrise.Weekday()),
+		RahuKalam:   domain.GetRahuKalam(sunrise.Weekday(), sunrise, sunset),
+		Yamaganda:   domain.GetYamaganda(sunrise.Weekday(), sunrise, sunset),
+		Gulika:      domain.GetGulika(sunrise.Weekday(), sunrise, sunset),
+	}
+
+	// Limb angle functions
+	elongation := func(jd float64) (float64, error) {
+		sun, moon, err := pc.sunMoonLongitudes(jd)
+		if err != nil {
+			return 0, err
+		}
+		return normalizeAngle360(moon - sun), nil
+	}
+	siderealMoon := func(jd float64) (float64, error) {
+		_, moon, err := pc.sunMoonLongitudes(jd)
+		if err != nil {
+			return 0, err
+		}
+		aya, err := pc.ephemeris.GetAyanamsa(jd, siderealMode)
+		if err != nil {
+			return 0, err
+		}
+		return normalizeAngle360(moon - aya), nil
+	}
+	siderealSum := func(jd float64) (float64, error) {
+		sun, moon, err := pc.sunMoonLongitudes(jd)
+		if err != nil {
+			return 0, err
+		}
+		aya, err := pc.ephemeris.GetAyanamsa(jd, siderealMode)
+		if err != nil {
+			return 0, err
+		}
+		return normalizeAngle360(sun + moon - 2*aya), nil
+	}
+
+	tithis, err := pc.findLimbSpans(elongation, domain.TithiSpan, sunriseJD, nextSunriseJD)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate tithis: %w", err)
+	}
+	for _, span := range tithis {
+		panchanga.Tithis = append(panchanga.Tithis, domain.NewTithi(span.index+1, toLocal(span.start), toLocal(span.end)))
+	}
+
+	karanas, err := pc.findLimbSpans(elongation, domain.KaranaSpan, sunriseJD, nextSunriseJD)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate karanas: %w", err)
+	}
+	for _, span := range karanas {
+		panchanga.Karanas = append(panchanga.Karanas, domain.NewKarana(span.index+1, toLocal(span.start), toLocal(span.end)))
+	}
+
+	nakshatras, err := pc.findLimbSpans(siderealMoon, domain.NakshatraSpan, sunriseJD, nextSunriseJD)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate nakshatras: %w", err)
+	}
+	for _, span := range nakshatras {
+		panchanga.Nakshatras = append(panchanga.Nakshatras, domain.NewNakshatra(span.index+1, toLocal(span.start), toLocal(span.end)))
+	}
+
+	yogas, err := pc.findLimbSpans(siderealSum, domain.YogaSpan, sunriseJD, nextSunriseJD)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate yogas: %w", err)
+	}
+	for _, span := range yogas {
+		panchanga.Yogas = append(panchanga.Yogas, domain.NewYoga(span.index+1, toLocal(span.start), toLocal(span.end)))
+	}
+
+	return panchanga, nil
+}
+
+// limbSpan holds the cycle index and exact start/end Julian Days of a limb
+type limbSpan struct {
+	index int
+	start float64
+	end   float64
+}
+
+// findLimbSpans finds every limb in force between two Julian Days
+func (pc *PanchangaCalculator) findLimbSpans(fn angleFunc, span, fromJD, toJD float64) ([]limbSpan, error) {
+	var spans []limbSpan
+	count := int(math.Round(360.0 / span))
+
+	jd := fromJD
+	for jd < toJD {
+		angle, err := fn(jd)
+		if err != nil {
+			return nil, err
+		}
+		index := int(angle/span) % count
+
+		start, err := pc.findAngleTime(fn, float64(index)*span, jd)
+		if err != nil {
+			return nil, err
+		}
+		end, err := pc.findAngleTime(fn, float64(index+1)*span, jd)
+		if err != nil {
+			return nil, err
+		}
+
+		if end <= jd {
+			return nil, fmt.Errorf("failed to converge on limb end near JD %.5f", jd)
+		}
+
+		spans = append(spans, limbSpan{index: index, start: start, end: end})
+
+		// Step just past the end of this limb (one second)
+		jd = end + 1.0/86400.0
+	}
+
+	return spans, nil
+}
+
+// findAngleTime finds the Julian Day closest to the guess at which the angle reaches the target
+func (pc *PanchangaCalculator) findAngleTime(fn angleFunc, target, guess float64) (float64, error) {
+	const step = 0.01 // days, for the numerical derivative
+
+	jd := guess
+	for i := 0; i < 50; i++ {
+		angle, err := fn(jd)
+		if err != nil {
+			return 0, err
+		}
+
+		diff := normalizeAngle180(target - angle)
+		if math.Abs(diff) < 1e-7 {
+			return jd, nil
+		}
+
+		next, err := fn(jd + step)
+		if err != nil {
+			return 0, err
+		}
+		rate := normalizeAngle180(next-angle) / step
+		if rate == 0 {
+			return 0, fmt.Errorf("angle is stationary near JD %.5f", jd)
+		}
+
+		jd += diff / rate
+	}
+
+	return jd, nil
+}
+
+// sunMoonLongitudes returns the tropical longitudes of the Sun and Moon
+func (pc *PanchangaCalculator) sunMoonLongitudes(julianDay float64) (float64, float64, error) {
+	sun, err := pc.ephemeris.CalculatePlanetPosition(julianDay, SE_SUN)
+	if err != nil {
+		return 0, 0, err
+	}
+	moon, err := pc.ephemeris.CalculatePlanetPosition(julianDay, SE_MOON)
+	if err != nil {
+		return 0, 0, err
+	}
+	return sun.Longitude, moon.Longitude, nil
+}
+
+// normalizeAngle180 normalizes an angle to the range [-180, 180)
+func normalizeAngle180(angle float64) float64 {
+	angle = normalizeAngle360(angle + 180)
+	return angle - 180
+}
@@ -49,6 +49,63 @@ func (pc *PlanetCalculator) CalculateAllPlanets(
 	return planets, nil
 }
 
+// CalculatePlanetsWithBodies calculates the default planets plus the optional bodies requested
+func (pc *PlanetCalculator) CalculatePlanetsWithBodies(
+	timeInfo *domain.TimeInfo,
+	houseCusps []float64,
+	bodies []string,
+) ([]domain.Planet, error) {
+
+	selection, err := ParseBodies(bodies)
+	if err != nil {
+		return nil, err
+	}
+
+	planets, err := pc.CalculateAllPlanets(timeInfo, houseCusps)
+	if err != nil {
+		return nil, err
+	}
+
+	julianDay := pc.ephemeris.GetJulianDay(timeInfo)
+	houseCalc := NewHouseCalculator(pc.ephemeris)
+
+	for _, planetID := range selection.PlanetIDs {
+		pos, err := pc.ephemeris.CalculatePlanetPosition(julianDay, planetID)
+		if err != nil {
+			// Asteroids beyond the main four need their .se1 file in SE_EPHE_PATH
+			return nil, fmt.Errorf("failed to calculate %s: %w", pc.ephemeris.GetPlanetName(planetID), err)
+		}
+
+		houseNumber := houseCalc.DetermineHouseForPlanet(pos.Longitude, houseCusps)
+		planets = append(planets, pos.ToDomainPlanet(pc.ephemeris, houseNumber))
+	}
+
+	// The South Node is opposite the true node when requested, otherwise the mean node
+	if selection.SouthNode {
+		var northNode *domain.Planet
+		for i := range planets {
+			if planets[i].Name == string(domain.TrueNode) {
+				northNode = &planets[i]
+				break
+			}
+			if planets[i].Name == string(domain.NorthNode) && northNode == nil {
+				northNode = &planets[i]
+			}
+		}
+
+		if northNode != nil {
+			longitude := normalizeAngle360(northNode.Longitude + 180)
+			houseNumber := houseCalc.DetermineHouseForPlanet(longitude, houseCusps)
+			southNode := domain.NewPlanet(
+				string(domain.SouthNode), longitude, -northNode.Latitude, northNode.Speed, houseNumber)
+			southNode.SetEquatorial(normalizeAngle360(northNode.RightAscension+180), -northNode.Declination)
+			planets = append(planets, southNode)
+		}
+	}
+
+	return planets, nil
+}
+
 // CalculateSinglePlanet calculates position for a single planet
 func (pc *PlanetCalculator) CalculateSinglePlanet(
 	planetName string,
@@ -81,48 +138,23 @@ func (pc *PlanetCalculator) CalculateSinglePlanet(
 	return &planet, nil
 }
 
-// CalculatePlanetaryDignities calculates dignities for all planets
-func (pc *PlanetCalculator) CalculatePlanetaryDignities(planets []domain.Planet) map[string]PlanetaryDignity {
-	dignities := make(map[string]PlanetaryDignity)
+// CalculatePlanetaryDignities calculates the essential dignities of the traditional planets
+func (pc *PlanetCalculator) CalculatePlanetaryDignities(
+	planets []domain.Planet,
+	isNight bool,
+	settings domain.DignitySettings,
+) map[string]domain.EssentialDignity {
+	dignities := make(map[string]domain.EssentialDignity)
 
 	for _, planet := range planets {
-		dignity := pc.calculatePlanetDignity(planet)
-		dignities[planet.Name] = dignity
+		if domain.HasEssentialDignities(planet.Name, settings.Rulership) {
+			dignities[planet.Name] = domain.NewEssentialDignity(planet.Name, planet.Longitude, isNight, settings)
+		}
 	}
 
 	return dignities
 }
 
-// calculatePlanetDignity calculates dignities for a single planet
-func (pc *PlanetCalculator) calculatePlanetDignity(planet domain.Planet) PlanetaryDignity {
-	dignity := PlanetaryDignity{
-		Planet: planet.Name,
-		Sign:   planet.Sign,
-	}
-
-	// Check various dignities
-	dignity.IsInDomicile = pc.isInDomicile(planet)
-	dignity.IsInExaltation = pc.isInExaltation(planet)
-	dignity.IsInDetriment = pc.isInDetriment(planet)
-	dignity.IsInFall = pc.isInFall(planet)
-	dignity.IsPeregrine = !dignity.IsInDomicile && !dignity.IsInExaltation && !dignity.IsInDetriment && !dignity.IsInFall
-
-	// Calculate dignity score (-2 to +2)
-	if dignity.IsInExaltation {
-		dignity.Score = 2
-	} else if dignity.IsInDomicile {
-		dignity.Score = 1
-	} else if dignity.IsInDetriment {
-		dignity.Score = -1
-	} else if dignity.IsInFall {
-		dignity.Score = -2
-	} else {
-		dignity.Score = 0 // Peregrine
-	}
-
-	return dignity
-}
-
 // CalculatePlanetaryReturns calculates when a planet returns to its natal position
 func (pc *PlanetCalculator) CalculatePlanetaryReturns(
 	natalPlanet domain.Planet,
@@ -193,62 +225,6 @@ func (pc *PlanetCalculator) getPlanetIDFromName(name string) int {
 	return -1
 }
 
-// isInDomicile checks if planet is in its domicile (ruling) sign
-func (pc *PlanetCalculator) isInDomicile(planet domain.Planet) bool {
-	domiciles := map[string][]string{
-		"Sun":     {"Leo"},
-		"Moon":    {"Cancer"},
-		"Mercury": {"Gemini", "Virgo"},
-		"Venus":   {"Taurus", "Libra"},
-		"Mars":    {"Aries", "Scorpio"},      // Traditional rulership
-		"Jupiter": {"Sagittarius", "Pisces"}, // Traditional rulership
-		"Saturn":  {"Capricorn", "Aquarius"}, // Traditional rulership
-		"Uranus":  {"Aquarius"},
-		"Neptune": {"Pisces"},
-		"Pluto":   {"Scorpio"},
-	}
-
-	if signs, exists := domiciles[planet.Name]; exists {
-		for _, sign := range signs {
-			if planet.Sign == sign {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-// isInExaltation checks if planet is in its exaltation sign
-func (pc *PlanetCalculator) isInExaltation(planet domain.Planet) bool {
-	exaltations := map[string]string{
-		"Sun":     "Aries",
-		"Moon":    "Taurus",
-		"Mercury": "Virgo",
-		"Venus":   "Pisces",
-		"Mars":    "Capricorn",
-		"Jupiter": "Cancer",
-		"Saturn":  "Libra",
-		"Uranus":  "Scorpio",
-		"Neptune": "Aquarius",
-		"Pluto":   "Aries",
-	}
-
-	if sign, exists := exaltations[planet.Name]; exists {
-		return planet.Sign == sign
-	}
-	return false
-}
-
-// isInDetriment checks if planet is in its detriment sign
-func (pc *PlanetCalculator) isInDetriment(planet domain.Planet) bool {
-	return planet.IsInDetriment()
-}
-
-// isInFall checks if planet is in its fall sign
-func (pc *PlanetCalculator) isInFall(planet domain.Planet) bool {
-	return planet.IsInFall()
-}
-
 // getOrbitalPeriod returns the orbital period of a planet in days
 func (pc *PlanetCalculator) getOrbitalPeriod(planetName string) float64 {
 	orbitalPeriods := map[string]float64{
@@ -293,18 +269,6 @@ func getReturnType(planetName string) string {
 	return "Planetary Return"
 }
 
-// PlanetaryDignity represents the dignity of a planet in a sign
-type PlanetaryDignity struct {
-	Planet         string `json:"planet"`
-	Sign           string `json:"sign"`
-	IsInDomicile   bool   `json:"is_in_domicile"`
-	IsInExaltation bool   `json:"is_in_exaltation"`
-	IsInDetriment  bool   `json:"is_in_detriment"`
-	IsInFall       bool   `json:"is_in_fall"`
-	IsPeregrine    bool   `json:"is_peregrine"`
-	Score          int    `json:"score"` // -2 to +2
-}
-
 // PlanetaryReturn represents a planetary return
 type PlanetaryReturn struct {
 	Planet     string          `json:"planet"`
@@ -312,55 +276,48 @@ type PlanetaryReturn struct {
 	ReturnType string          `json:"return_type"`
 }
 
-// GetPlanetaryStrengths calculates overall strength scores for planets
-func (pc *PlanetCalculator) GetPlanetaryStrengths(
-	planets []domain.Planet,
-	dignities map[string]PlanetaryDignity,
-) map[string]PlanetaryStrength {
+// CalculateAccidentalDignities analyses the accidental condition of every planet with a known mean motion
+func (pc *PlanetCalculator) CalculateAccidentalDignities(planets []domain.Planet, isNight bool) map[string]domain.AccidentalDignity {
+	accidentals := make(map[string]domain.AccidentalDignity)
 
-	strengths := make(map[string]PlanetaryStrength)
+	sunIndex := -1
+	for i, planet := range planets {
+		if planet.Name == string(domain.Sun) {
+			sunIndex = i
+		}
+	}
+	if sunIndex < 0 {
+		return accidentals
+	}
 
 	for _, planet := range planets {
-		dignity := dignities[planet.Name]
-
-		strength := PlanetaryStrength{
-			Planet:       planet.Name,
-			DignityScore: dignity.Score,
-			HouseScore:   pc.getHouseScore(planet.House),
-			AspectScore:  0, // Would be calculated from aspects
-			OverallScore: 0,
+		if domain.GetMeanDailyMotion(planet.Name) > 0 {
+			accidentals[planet.Name] = domain.NewAccidentalDignity(planet, planets[sunIndex].Longitude, isNight)
 		}
-
-		// Calculate overall score (this is simplified)
-		strength.OverallScore = strength.DignityScore + strength.HouseScore + strength.AspectScore
-
-		strengths[planet.Name] = strength
 	}
 
-	return strengths
+	return accidentals
 }
 
-// getHouseScore returns a score for house position
-func (pc *PlanetCalculator) getHouseScore(houseNumber int) int {
-	// Angular houses are stronger
-	if houseNumber == 1 || houseNumber == 4 || houseNumber == 7 || houseNumber == 10 {
-		return 2
-	}
-	// Succedent houses are moderate
-	if houseNumber == 2 || houseNumber == 5 || houseNumber == 8 || houseNumber == 11 {
-		return 1
+// GetPlanetaryStrengths calculates overall strength scores for planets from their
+// essential dignities and accidental condition
+func (pc *PlanetCalculator) GetPlanetaryStrengths(
+	planets []domain.Planet,
+	dignities map[string]domain.EssentialDignity,
+	accidentals map[string]domain.AccidentalDignity,
+) map[string]domain.PlanetaryStrength {
+
+	strengths := make(map[string]domain.PlanetaryStrength)
+
+	for _, planet := range planets {
+		accidental, exists := accidentals[planet.Name]
+		if !exists {
+			continue
+		}
+		strengths[planet.Name] = domain.NewPlanetaryStrength(dignities[planet.Name], accidental)
 	}
-	// Cadent houses are weaker
-	return 0
-}
 
-// PlanetaryStrength represents the overall strength of a planet
-type PlanetaryStrength struct {
-	Planet       string `json:"planet"`
-	DignityScore int    `json:"dignity_score"`
-	HouseScore   int    `json:"house_score"`
-	AspectScore  int    `json:"aspect_score"`
-	OverallScore int    `json:"overall_score"`
+	return strengths
 }
 
 // Helper function to create a domain error (assuming this exists)
@@ -0,0 +1,522 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+	"math"
+)
+
+// shadbalaPlanets lists the seven planets that receive shadbala
+var shadbalaPlanets = []string{"Sun", "Moon", "Mars", "Mercury", "Jupiter", "Venus", "Saturn"}
+
+// chaldeanOrder lists the planetary hour sequence
+var chaldeanOrder = []string{"Saturn", "Jupiter", "Mars", "Sun", "Venus", "Mercury", "Moon"}
+
+// weekdayLords lists the weekday lords starting with Sunday
+var weekdayLords = []string{"Sun", "Moon", "Mars", "Mercury", "Jupiter", "Venus", "Saturn"}
+
+// deepExaltation contains the sidereal longitude of each planet's deepest exaltation
+var deepExaltation = map[string]float64{
+	"Sun":     10,  // 10° Aries
+	"Moon":    33,  // 3° Taurus
+	"Mars":    298, // 28° Capricorn
+	"Mercury": 165, // 15° Virgo
+	"Jupiter": 95,  // 5° Cancer
+	"Venus":   357, // 27° Pisces
+	"Saturn":  200, // 20° Libra
+}
+
+// moolatrikona contains the moolatrikona sign (0-11) and degree range of each planet
+var moolatrikona = map[string]struct {
+	sign     int
+	from, to float64
+}{
+	"Sun":     {4, 0, 20},
+	"Moon":    {1, 3, 30},
+	"Mars":    {0, 0, 12},
+	"Mercury": {5, 15, 20},
+	"Jupiter": {8, 0, 10},
+	"Venus":   {6, 0, 15},
+	"Saturn":  {10, 0, 20},
+}
+
+// naturalRelationships contains each planet's natural friends (1), neutrals (0) and enemies (-1)
+var naturalRelationships = map[string]map[string]int{
+	"Sun":     {"Moon": 1, "Mars": 1, "Jupiter": 1, "Mercury": 0, "Venus": -1, "Saturn": -1},
+	"Moon":    {"Sun": 1, "Mercury": 1, "Mars": 0, "Jupiter": 0, "Venus": 0, "Saturn": 0},
+	"Mars":    {"Sun": 1, "Moon": 1, "Jupiter": 1, "Venus": 0, "Saturn": 0, "Mercury": -1},
+	"Mercury": {"Sun": 1, "Venus": 1, "Mars": 0, "Jupiter": 0, "Saturn": 0, "Moon": -1},
+	"Jupiter": {"Sun": 1, "Moon": 1, "Mars": 1, "Saturn": 0, "Mercury": -1, "Venus": -1},
+	"Venus":   {"Mercury": 1, "Saturn": 1, "Mars": 0, "Jupiter": 0, "Sun": -1, "Moon": -1},
+	"Saturn":  {"Mercury": 1, "Venus": 1, "Jupiter": 0, "Sun": -1, "Moon": -1, "Mars": -1},
+}
+
+// naisargikaBala contains the fixed natural strength of each planet in virupas
+var naisargikaBala = map[string]float64{
+	"Sun": 60, "Moon": 51.43, "Venus": 42.86, "Jupiter": 34.29,
+	"Mercury": 25.71, "Mars": 17.14, "Saturn": 8.57,
+}
+
+// requiredRupas contains the minimum shadbala in rupas for each planet to be considered strong
+var requiredRupas = map[string]float64{
+	"Sun": 6.5, "Moon": 6, "Mars": 5, "Mercury": 7, "Jupiter": 6.5, "Venus": 5.5, "Saturn": 5,
+}
+
+// meanDailyMotion contains the mean geocentric motion of each planet in degrees per day
+var meanDailyMotion = map[string]float64{
+	"Mars": 0.524, "Mercury": 0.9856, "Jupiter": 0.0831, "Venus": 0.9856, "Saturn": 0.0335,
+}
+
+// saptavargaDivisions lists the seven vargas used for saptavargaja bala
+var saptavargaDivisions = []int{1, 2, 3, 7, 9, 12, 30}
+
+// kaliYugaEpoch is the Julian Day of the Kali Yuga epoch (midnight, 18 February 3102 BCE)
+const kaliYugaEpoch = 588465.5
+
+// shadbalaContext holds the birth-time data shared by the temporal strengths
+type shadbalaContext struct {
+	julianDay     float64
+	sunrise       float64
+	sunset        float64
+	nextSunrise   float64
+	varaLord      string
+	horaLord      string
+	abdaLord      string
+	masaLord      string
+	ayanamsaValue float64
+	positions     map[string]domain.Planet
+	signs         map[string]int // Rasi sign index (0-11) of each planet
+	elongation    float64        // Moon - Sun in degrees [0, 360)
+}
+
+// CalculateShadbala calculates the six-fold strength of the seven planets
+func (vc *VedicCalculator) CalculateShadbala(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	positions *SiderealPositions,
+) ([]domain.Shadbala, error) {
+
+	ctx, err := vc.newShadbalaContext(timeInfo, location, positions)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []domain.Shadbala
+	for _, name := range shadbalaPlanets {
+		planet := ctx.positions[name]
+
+		sthana := vc.calculateSthanaBala(planet, ctx)
+		kala := vc.calculateKalaBala(planet, ctx)
+
+		result := domain.Shadbala{
+			Planet:        name,
+			SthanaBala:    sthana,
+			DigBala:       calculateDigBala(planet, positions),
+			KalaBala:      kala,
+			ChestaBala:    calculateChestaBala(planet, kala, ctx),
+			Naisargika:    naisargikaBala[name],
+			DrikBala:      calculateDrikBala(planet, ctx),
+			RequiredRupas: requiredRupas[name],
+		}
+
+		result.Total = result.SthanaBala.Total + result.DigBala + result.KalaBala.Total +
+			result.ChestaBala + result.Naisargika + result.DrikBala
+		result.Rupas = result.Total / 60.0
+		result.StrengthRatio = result.Rupas / result.RequiredRupas
+		result.IsStrong = result.Rupas >= result.RequiredRupas
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// newShadbalaContext gathers sunrise, sunset and the time lords for the birth moment
+func (vc *VedicCalculator) newShadbalaContext(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	positions *SiderealPositions,
+) (*shadbalaContext, error) {
+
+	julianDay := vc.ephemeris.GetJulianDay(timeInfo)
+
+	ctx := &shadbalaContext{
+		julianDay:     julianDay,
+		ayanamsaValue: positions.AyanamsaValue,
+		positions:     make(map[string]domain.Planet),
+		signs:         make(map[string]int),
+	}
+	for _, planet := range positions.Planets {
+		ctx.positions[planet.Name] = planet
+		ctx.signs[planet.Name] = int(normalizeAngle360(planet.Longitude) / 30.0)
+	}
+	for _, name := range shadbalaPlanets {
+		if _, exists := ctx.positions[name]; !exists {
+			return nil, fmt.Errorf("missing position for %s", name)
+		}
+	}
+	ctx.elongation = normalizeAngle360(ctx.positions["Moon"].Longitude - ctx.positions["Sun"].Longitude)
+
+	// The Vedic day runs from sunrise to sunrise, so search from local midnight
+	// and fall back to the previous sunrise for births before dawn
+	midnightJD := vc.ephemeris.GetLocalMidnightJulianDay(timeInfo)
+
+	sunrise, err := vc.ephemeris.CalculateRiseSet(midnightJD, SE_SUN, location, SE_CALC_RISE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate sunrise: %w", err)
+	}
+	if julianDay < sunrise {
+		sunrise, err = vc.ephemeris.CalculateRiseSet(midnightJD-1, SE_SUN, location, SE_CALC_RISE)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate sunrise: %w", err)
+		}
+	}
+	sunset, err := vc.ephemeris.CalculateRiseSet(sunrise, SE_SUN, location, SE_CALC_SET)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate sunset: %w", err)
+	}
+	nextSunrise, err := vc.ephemeris.CalculateRiseSet(sunset, SE_SUN, location, SE_CALC_RISE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate next sunrise: %w", err)
+	}
+
+	ctx.sunrise = sunrise
+	ctx.sunset = sunset
+	ctx.nextSunrise = nextSunrise
+
+	weekday := int(domain.JulianDayToTime(sunrise).In(timeInfo.LocalTime.Location()).Weekday())
+	ctx.varaLord = weekdayLords[weekday]
+
+	// Each hora is one hour from sunrise, following the Chaldean order from the day lord
+	hora := int((julianDay - sunrise) * 24)
+	ctx.horaLord = chaldeanOrder[(chaldeanIndex(ctx.varaLord)+hora)%7]
+
+	// Year and month lords are the weekday lords of the first day of the current
+	// 360-day year and 30-day month counted from the Kali Yuga epoch
+	ahargana := math.Floor(julianDay - kaliYugaEpoch)
+	ctx.abdaLord = weekdayLords[julianDayWeekday(kaliYugaEpoch+math.Floor(ahargana/360)*360)]
+	ctx.masaLord = weekdayLords[julianDayWeekday(kaliYugaEpoch+math.Floor(ahargana/30)*30)]
+
+	return ctx, nil
+}
+
+// calculateSthanaBala calculates the positional strength of a planet
+func (vc *VedicCalculator) calculateSthanaBala(planet domain.Planet, ctx *shadbalaContext) domain.SthanaBala {
+	var sthana domain.SthanaBala
+
+	// Uccha: one third of the distance from the debilitation point
+	debilitation := normalizeAngle360(deepExaltation[planet.Name] + 180)
+	sthana.Uccha = domain.AngularDistance(planet.Longitude, debilitation) / 3.0
+
+	// Saptavargaja: dignity of the planet in each of the seven vargas
+	for _, division := range saptavargaDivisions {
+		sthana.Saptavargaja += vc.vargaDignityPoints(planet, division, ctx)
+	}
+
+	// Ojayugma: Moon and Venus gain in even signs, the others in odd signs
+	prefersEven := planet.Name == "Moon" || planet.Name == "Venus"
+	rasiSign := ctx.signs[planet.Name]
+	navamsaSign := domain.CalculateVargaSign(planet.Longitude, 9)
+	if (rasiSign%2 == 1) == prefersEven {
+		sthana.Ojayugma += 15
+	}
+	if (navamsaSign%2 == 1) == prefersEven {
+		sthana.Ojayugma += 15
+	}
+
+	// Kendradi: by whole-sign house from the lagna
+	switch planet.House {
+	case 1, 4, 7, 10:
+		sthana.Kendradi = 60
+	case 2, 5, 8, 11:
+		sthana.Kendradi = 30
+	default:
+		sthana.Kendradi = 15
+	}
+
+	// Drekkana: male planets in the first, neuter in the second, female in the third decanate
+	decanate := int(math.Mod(planet.Longitude, 30) / 10)
+	switch planet.Name {
+	case "Sun", "Mars", "Jupiter":
+		if decanate == 0 {
+			sthana.Drekkana = 15
+		}
+	case "Mercury", "Saturn":
+		if decanate == 1 {
+			sthana.Drekkana = 15
+		}
+	case "Moon", "Venus":
+		if decanate == 2 {
+			sthana.Drekkana = 15
+		}
+	}
+
+	sthana.Total = sthana.Uccha + sthana.Saptavargaja + sthana.Ojayugma + sthana.Kendradi + sthana.Drekkana
+	return sthana
+}
+
+// vargaDignityPoints returns the saptavargaja virupas of a planet in one varga
+func (vc *VedicCalculator) vargaDignityPoints(planet domain.Planet, division int, ctx *shadbalaContext) float64 {
+	sign := domain.CalculateVargaSign(planet.Longitude, division)
+	mt := moolatrikona[planet.Name]
+
+	if sign == mt.sign {
+		// In the rasi chart moolatrikona applies only within its degree range
+		degree := math.Mod(planet.Longitude, 30)
+		if division != 1 || (degree >= mt.from && degree < mt.to) {
+			return 45
+		}
+	}
+
+	lord := domain.GetRulerForSign(domain.GetSignByNumber(sign + 1))
+	if lord == planet.Name {
+		return 30
+	}
+
+	switch compoundRelationship(planet.Name, lord, ctx) {
+	case 2:
+		return 20 // Great friend
+	case 1:
+		return 15 // Friend
+	case 0:
+		return 10 // Neutral
+	case -1:
+		return 4 // Enemy
+	default:
+		return 2 // Great enemy
+	}
+}
+
+// compoundRelationship combines natural and temporary relationships (-2 great enemy ... 2 great friend)
+func compoundRelationship(planet, other string, ctx *shadbalaContext) int {
+	natural := naturalRelationships[planet][other]
+
+	// Planets in the 2nd, 3rd, 4th, 10th, 11th and 12th from each other are temporary friends
+	temporary := -1
+	switch (ctx.signs[other] - ctx.signs[planet] + 12) % 12 {
+	case 1, 2, 3, 9, 10, 11:
+		temporary = 1
+	}
+
+	return natural + temporary
+}
+
+// calculateDigBala calculates directional strength from the distance to the powerless point
+func calculateDigBala(planet domain.Planet, positions *SiderealPositions) float64 {
+	var strongest float64
+	switch planet.Name {
+	case "Jupiter", "Mercury":
+		strongest = positions.Ascendant
+	case "Sun", "Mars":
+		strongest = positions.Midheaven
+	case "Saturn":
+		strongest = positions.Ascendant + 180
+	default: // Moon, Venus
+		strongest = positions.Midheaven + 180
+	}
+
+	powerless := normalizeAngle360(strongest + 180)
+	return domain.AngularDistance(planet.Longitude, powerless) / 3.0
+}
+
+// calculateKalaBala calculates the temporal strength of a planet
+func (vc *VedicCalculator) calculateKalaBala(planet domain.Planet, ctx *shadbalaContext) domain.KalaBala {
+	var kala domain.KalaBala
+	isDay := ctx.julianDay < ctx.sunset
+
+	// Nathonnatha: nocturnal planets peak at local midnight, diurnal planets at noon
+	noon := (ctx.sunrise + ctx.sunset) / 2
+	hoursFromMidnight := math.Min(math.Abs(ctx.julianDay-(noon-0.5)), math.Abs(ctx.julianDay-(noon+0.5))) * 24
+	nocturnal := 60 * (1 - math.Min(hoursFromMidnight, 12)/12)
+	switch planet.Name {
+	case "Moon", "Mars", "Saturn":
+		kala.Nathonnatha = nocturnal
+	case "Mercury":
+		kala.Nathonnatha = 60
+	default:
+		kala.Nathonnatha = 60 - nocturnal
+	}
+
+	// Paksha: benefics gain with the waxing Moon, malefics with the waning Moon
+	kala.Paksha = pakshaBala(planet.Name, ctx.elongation)
+	if planet.Name == "Moon" {
+		kala.Paksha *= 2
+	}
+
+	// Tribhaga: lords of the thirds of day and night, Jupiter always strong
+	var part int
+	if isDay {
+		part = int((ctx.julianDay - ctx.sunrise) / (ctx.sunset - ctx.sunrise) * 3)
+	} else {
+		part = int((ctx.julianDay - ctx.sunset) / (ctx.nextSunrise - ctx.sunset) * 3)
+	}
+	part = int(math.Min(math.Max(float64(part), 0), 2))
+	dayThirds := []string{"Mercury", "Sun", "Saturn"}
+	nightThirds := []string{"Moon", "Venus", "Mars"}
+	if planet.Name == "Jupiter" ||
+		(isDay && dayThirds[part] == planet.Name) ||
+		(!isDay && nightThirds[part] == planet.Name) {
+		kala.Tribhaga = 60
+	}
+
+	// Lords of the year, month, weekday and hour
+	if ctx.abdaLord == planet.Name {
+		kala.Abda = 15
+	}
+	if ctx.masaLord == planet.Name {
+		kala.Masa = 30
+	}
+	if ctx.varaLord == planet.Name {
+		kala.Vara = 45
+	}
+	if ctx.horaLord == planet.Name {
+		kala.Hora = 60
+	}
+
+	// Ayana: from the tropical declination; Moon and Saturn gain in southern declination
+	declination := eclipticDeclination(planet.Longitude+ctx.ayanamsaValue, planet.Latitude, ctx.julianDay)
+	switch planet.Name {
+	case "Moon", "Saturn":
+		kala.Ayana = (24 - declination) / 48 * 60
+	case "Mercury":
+		kala.Ayana = (24 + math.Abs(declination)) / 48 * 60
+	default:
+		kala.Ayana = (24 + declination) / 48 * 60
+	}
+	if planet.Name == "Sun" {
+		kala.Ayana *= 2
+	}
+
+	kala.Total = kala.Nathonnatha + kala.Paksha + kala.Tribhaga + kala.Abda +
+		kala.Masa + kala.Vara + kala.Hora + kala.Ayana
+	return kala
+}
+
+// pakshaBala returns the fortnight strength from the Sun-Moon elongation
+func pakshaBala(name string, elongation float64) float64 {
+	paksha := elongation
+	if paksha > 180 {
+		paksha = 360 - paksha
+	}
+
+	switch name {
+	case "Sun", "Mars", "Saturn":
+		return 60 - paksha/3
+	default:
+		return paksha / 3
+	}
+}
+
+// calculateChestaBala calculates motional strength; the luminaries borrow ayana and paksha bala
+func calculateChestaBala(planet domain.Planet, kala domain.KalaBala, ctx *shadbalaContext) float64 {
+	switch planet.Name {
+	case "Sun":
+		return kala.Ayana / 2
+	case "Moon":
+		return pakshaBala("Moon", ctx.elongation)
+	}
+
+	ratio := planet.Speed / meanDailyMotion[planet.Name]
+	switch {
+	case ratio < 0:
+		return 60 // Vakra (retrograde)
+	case ratio < 0.1:
+		return 15 // Vikala (stationary)
+	case ratio < 0.5:
+		return 15 // Mandatara (very slow)
+	case ratio < 0.95:
+		return 30 // Manda (slow)
+	case ratio <= 1.05:
+		return 7.5 // Sama (mean motion)
+	case ratio < 1.5:
+		return 45 // Chara (fast)
+	default:
+		return 30 // Atichara (very fast)
+	}
+}
+
+// calculateDrikBala sums the aspects received from benefics minus those from malefics
+func calculateDrikBala(planet domain.Planet, ctx *shadbalaContext) float64 {
+	waxing := ctx.elongation < 180
+
+	var total float64
+	for _, name := range shadbalaPlanets {
+		if name == planet.Name {
+			continue
+		}
+		aspecting := ctx.positions[name]
+		drishti := sputaDrishti(name, normalizeAngle360(planet.Longitude-aspecting.Longitude))
+
+		benefic := name == "Jupiter" || name == "Venus" || name == "Mercury" || (name == "Moon" && waxing)
+		if benefic {
+			total += drishti
+		} else {
+			total -= drishti
+		}
+	}
+
+	return total / 4.0
+}
+
+// sputaDrishti returns the aspect value in virupas cast across an angle, with the special
+// full aspects of Mars, Jupiter and Saturn
+func sputaDrishti(name string, angle float64) float64 {
+	var value float64
+	switch {
+	case angle >= 30 && angle < 60:
+		value = (angle - 30) / 2
+	case angle >= 60 && angle < 90:
+		value = angle - 45
+	case angle >= 90 && angle < 120:
+		value = (120-angle)/2 + 30
+	case angle >= 120 && angle < 150:
+		value = 150 - angle
+	case angle >= 150 && angle < 180:
+		value = (angle - 150) * 2
+	case angle >= 180 && angle < 300:
+		value = (300 - angle) / 2
+	}
+
+	switch name {
+	case "Mars":
+		if (angle >= 90 && angle < 120) || (angle >= 210 && angle < 240) {
+			value += 15
+		}
+	case "Jupiter":
+		if (angle >= 120 && angle < 150) || (angle >= 240 && angle < 270) {
+			value += 30
+		}
+	case "Saturn":
+		if (angle >= 60 && angle < 90) || (angle >= 270 && angle < 300) {
+			value += 45
+		}
+	}
+
+	return value
+}
+
+// eclipticDeclination returns the declination of a tropical ecliptic position using the mean obliquity
+func eclipticDeclination(longitude, latitude, julianDay float64) float64 {
+	t := (julianDay - 2451545.0) / 36525.0
+	obliquity := (23.439291 - 0.0130042*t) * math.Pi / 180
+	lon := longitude * math.Pi / 180
+	lat := latitude * math.Pi / 180
+
+	sinDec := math.Sin(lat)*math.Cos(obliquity) + math.Cos(lat)*math.Sin(obliquity)*math.Sin(lon)
+	return math.Asin(sinDec) * 180 / math.Pi
+}
+
+// chaldeanIndex returns the position of a planet in the Chaldean order
+func chaldeanIndex(name string) int {
+	for i, planet := range chaldeanOrder {
+		if planet == name {
+			return i
+		}
+	}
+	return 0
+}
+
+// julianDayWeekday returns the weekday (0 = Sunday) of the civil day containing a Julian Day
+func julianDayWeekday(julianDay float64) int {
+	return int(math.Mod(math.Floor(julianDay+1.5), 7))
+}
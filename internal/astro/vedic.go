@@ -0,0 +1,156 @@
+package astro
+
+import (
+	"astroeph-api/internal/domain"
+	"fmt"
+)
+
+// VedicCalculator handles sidereal (Jyotish) calculations
+type VedicCalculator struct {
+	ephemeris *Ephemeris
+}
+
+// NewVedicCalculator creates a new Vedic calculator
+func NewVedicCalculator(ephemeris *Ephemeris) *VedicCalculator {
+	return &VedicCalculator{
+		ephemeris: ephemeris,
+	}
+}
+
+// Vedic names for the lunar nodes
+const (
+	Rahu = "Rahu"
+	Ketu = "Ketu"
+)
+
+// SiderealPositions holds sidereal positions of the nine grahas and the lagna
+type SiderealPositions struct {
+	Ayanamsa      string          `json:"ayanamsa"`
+	AyanamsaValue float64         `json:"ayanamsa_value"` // Ayanamsa in degrees
+	Ascendant     float64         `json:"ascendant"`      // Sidereal lagna longitude
+	Midheaven     float64         `json:"midheaven"`      // Sidereal MC longitude
+	Planets       []domain.Planet `json:"planets"`        // Sidereal planets with whole-sign houses
+}
+
+// CalculateSiderealPositions calculates sidereal positions of the grahas and the lagna
+func (vc *VedicCalculator) CalculateSiderealPositions(
+	timeInfo *domain.TimeInfo,
+	location *domain.Location,
+	ayanamsa string,
+) (*SiderealPositions, error) {
+
+	julianDay := vc.ephemeris.GetJulianDay(timeInfo)
+
+	ayanamsaValue, err := vc.ephemeris.GetAyanamsa(julianDay, vc.ephemeris.GetSiderealModeCode(ayanamsa))
+	if err != nil {
+		return nil, err
+	}
+
+	// Tropical angles shifted by the ayanamsa give the sidereal lagna and MC. The angles do not
+	// depend on the house system; whole signs can be drawn at every latitude, unlike Placidus.
+	housesData, err := vc.ephemeris.CalculateHouses(julianDay, location.Latitude, location.Longitude, 'W')
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate lagna: %w", err)
+	}
+
+	positions := &SiderealPositions{
+		Ayanamsa:      ayanamsa,
+		AyanamsaValue: ayanamsaValue,
+		Ascendant:     normalizeAngle360(housesData.Ascendant - ayanamsaValue),
+		Midheaven:     normalizeAngle360(housesData.Midheaven - ayanamsaValue),
+	}
+
+	grahas := []int{
+		SE_SUN, SE_MOON, SE_MARS, SE_MERCURY, SE_JUPITER, SE_VENUS, SE_SATURN, SE_MEAN_NODE,
+	}
+
+	lagnaSign := int(positions.Ascendant / 30.0)
+
+	for _, planetID := range grahas {
+		pos, err := vc.ephemeris.CalculatePlanetPosition(julianDay, planetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate %s: %w", vc.ephemeris.GetPlanetName(planetID), err)
+		}
+
+		name := vc.ephemeris.GetPlanetName(planetID)
+		if planetID == SE_MEAN_NODE {
+			name = Rahu
+		}
+
+		longitude := normalizeAngle360(pos.Longitude - ayanamsaValue)
+		planet := domain.NewPlanet(name, longitude, pos.Latitude, pos.LongSpeed, wholeSignHouse(longitude, lagnaSign))
+		positions.Planets = append(positions.Planets, planet)
+
+		// Ketu is always exactly opposite Rahu
+		if planetID == SE_MEAN_NODE {
+			ketuLongitude := normalizeAngle360(longitude + 180)
+			ketu := domain.NewPlanet(Ketu, ketuLongitude, -pos.Latitude, pos.LongSpeed, wholeSignHouse(ketuLongitude, lagnaSign))
+			positions.Planets = append(positions.Planets, ketu)
+		}
+	}
+
+	return positions, nil
+}
+
+// CalculateVargas calculates the requested divisional charts from sidereal positions
+func (vc *VedicCalculator) CalculateVargas(positions *SiderealPositions, divisions []int) ([]domain.VargaChart, error) {
+	if len(divisions) == 0 {
+		divisions = GetDefaultVargaDivisions()
+	}
+
+	var vargas []domain.VargaChart
+	for _, division := range divisions {
+		info := domain.GetVargaInfo(division)
+		if info == nil {
+			return nil, fmt.Errorf("unsupported divisional chart: D%d", division)
+		}
+
+		lagnaSign := domain.CalculateVargaSign(positions.Ascendant, division)
+
+		varga := domain.VargaChart{
+			Division:  division,
+			Code:      info.Code,
+			Name:      info.Name,
+			Ascendant: domain.NewVargaPlacement("Ascendant", positions.Ascendant, division, lagnaSign, false),
+		}
+
+		for _, planet := range positions.Planets {
+			placement := domain.NewVargaPlacement(planet.Name, planet.Longitude, division, lagnaSign, planet.IsRetrograde)
+			varga.Placements = append(varga.Placements, placement)
+		}
+
+		vargas = append(vargas, varga)
+	}
+
+	return vargas, nil
+}
+
+// GetDefaultVargaDivisions returns every supported divisional chart (D1 to D60)
+func GetDefaultVargaDivisions() []int {
+	var divisions []int
+	for _, info := range domain.GetVargaInfos() {
+		divisions = append(divisions, info.Division)
+	}
+	return divisions
+}
+
+// GetAvailableAyanamsas returns the supported ayanamsa names
+func GetAvailableAyanamsas() []string {
+	return []string{"Lahiri", "Raman", "Krishnamurti", "Fagan-Bradley", "Yukteshwar", "True Chitra"}
+}
+
+// IsValidAyanamsa checks if an ayanamsa name is supported
+func IsValidAyanamsa(ayanamsa string) bool {
+	for _, name := range GetAvailableAyanamsas() {
+		if name == ayanamsa {
+			return true
+		}
+	}
+	return false
+}
+
+// wholeSignHouse returns the whole-sign house of a longitude counted from the lagna sign (0-11)
+func wholeSignHouse(longitude float64, lagnaSign int) int {
+	sign := int(normalizeAngle360(longitude) / 30.0)
+	return (sign-lagnaSign+12)%12 + 1
+}
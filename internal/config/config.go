@@ -9,6 +9,7 @@ type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	Logging  LoggingConfig
+	Aspects  AspectsConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -28,6 +29,11 @@ type LoggingConfig struct {
 	Format string
 }
 
+// AspectsConfig holds aspect calculation configuration
+type AspectsConfig struct {
+	CustomAspectsFile string // JSON file with custom aspects available to every request
+}
+
 // Load loads configuration from environment variables and defaults
 func Load() *Config {
 	return &Config{
@@ -42,6 +48,9 @@ func Load() *Config {
 			Level:  getEnvOrDefault("LOG_LEVEL", "info"),
 			Format: getEnvOrDefault("LOG_FORMAT", "console"),
 		},
+		Aspects: AspectsConfig{
+			CustomAspectsFile: getEnvOrDefault("CUSTOM_ASPECTS_FILE", ""),
+		},
 	}
 }
 
@@ -0,0 +1,309 @@
+package domain
+
+import "math"
+
+// Solar conditions of a planet by its distance from the Sun
+const (
+	SolarCazimi     = "cazimi"      // Within 17' of the Sun's center
+	SolarCombust    = "combust"     // Within the planet's combustion orb
+	SolarUnderBeams = "under_beams" // Within 17° of the Sun
+	SolarFree       = "free"        // Free from the Sun's beams
+)
+
+// Solar phases
+const (
+	PhaseOriental   = "oriental"   // Rises before the Sun
+	PhaseOccidental = "occidental" // Sets after the Sun
+	PhaseWaxing     = "waxing"     // Moon increasing in light
+	PhaseWaning     = "waning"     // Moon decreasing in light
+)
+
+// Sects
+const (
+	SectDiurnal   = "diurnal"
+	SectNocturnal = "nocturnal"
+)
+
+// Motion relative to the mean daily motion
+const (
+	MotionFast       = "fast"
+	MotionSlow       = "slow"
+	MotionStationary = "stationary"
+	MotionRetrograde = "retrograde"
+)
+
+// Orbs of the Sun's beams in degrees
+const (
+	CazimiOrb     = 17.0 / 60
+	UnderBeamsOrb = 17.0
+)
+
+// stationaryRatio is the fraction of the mean motion below which a planet is stationary
+const stationaryRatio = 0.1
+
+// Accidental fortitude and debility points (after Lilly; joys, sect and hayz are additions)
+const (
+	AngularPoints       = 5 // 1st and 10th houses
+	CardinalHousePoints = 4 // 4th, 7th and 11th houses
+	SuccedentPoints     = 3 // 2nd and 5th houses
+	NinthHousePoints    = 2
+	ThirdHousePoints    = 1
+	TwelfthHousePoints  = -5
+	EvilHousePoints     = -2 // 6th and 8th houses
+	DirectPoints        = 4
+	RetrogradePoints    = -5
+	FastPoints          = 2
+	SlowPoints          = -2
+	SolarPhasePoints    = 2 // Superiors oriental, inferiors occidental, Moon waxing
+	CazimiPoints        = 5
+	FreeFromSunPoints   = 5
+	CombustPoints       = -5
+	UnderBeamsPoints    = -4
+	JoyPoints           = 2
+	InSectPoints        = 1
+	HayzPoints          = 2
+)
+
+// AccidentalDignity describes a planet's condition by house, Sun, sect and motion
+type AccidentalDignity struct {
+	Planet         string  `json:"planet"`
+	House          int     `json:"house"`
+	Angularity     string  `json:"angularity"` // angular, succedent or cadent
+	InJoy          bool    `json:"in_joy"`
+	SolarCondition string  `json:"solar_condition,omitempty"` // cazimi, combust, under_beams or free
+	SolarPhase     string  `json:"solar_phase,omitempty"`     // oriental or occidental; waxing or waning for the Moon
+	Sect           string  `json:"sect,omitempty"`            // diurnal or nocturnal
+	InSect         bool    `json:"in_sect"`
+	Hayz           bool    `json:"hayz"`        // In sect, above the horizon and in a sign of its gender
+	SpeedRatio     float64 `json:"speed_ratio"` // Daily motion relative to the mean motion
+	Motion         string  `json:"motion"`      // fast, slow, stationary or retrograde
+	Score          int     `json:"score"`
+}
+
+// PlanetaryStrength combines essential and accidental dignity into one score
+type PlanetaryStrength struct {
+	Planet          string `json:"planet"`
+	DignityScore    int    `json:"dignity_score"`
+	AccidentalScore int    `json:"accidental_score"`
+	OverallScore    int    `json:"overall_score"`
+}
+
+// GetMeanDailyMotion returns the mean daily motion of a planet in degrees, or 0 if unknown
+func GetMeanDailyMotion(planetName string) float64 {
+	meanMotions := map[string]float64{
+		string(Sun):     0.9856,
+		string(Moon):    13.1764,
+		string(Mercury): 0.9856,
+		string(Venus):   0.9856,
+		string(Mars):    0.5240,
+		string(Jupiter): 0.0831,
+		string(Saturn):  0.0335,
+		string(Uranus):  0.0117,
+		string(Neptune): 0.0060,
+		string(Pluto):   0.0040,
+	}
+	return meanMotions[planetName]
+}
+
+// GetPlanetaryJoy returns the house in which a planet rejoices, or 0 if none
+func GetPlanetaryJoy(planetName string) int {
+	joys := map[string]int{
+		string(Mercury): 1,
+		string(Moon):    3,
+		string(Venus):   5,
+		string(Mars):    6,
+		string(Sun):     9,
+		string(Jupiter): 11,
+		string(Saturn):  12,
+	}
+	return joys[planetName]
+}
+
+// GetHouseAngularity returns "angular", "succedent" or "cadent" for a house number
+func GetHouseAngularity(houseNumber int) string {
+	switch houseNumber % 3 {
+	case 1:
+		return "angular"
+	case 2:
+		return "succedent"
+	default:
+		return "cadent"
+	}
+}
+
+// GetSolarPhase returns whether a planet is oriental or occidental of the Sun,
+// or waxing or waning for the Moon
+func GetSolarPhase(planet Planet, sunLongitude float64) string {
+	// Elongation east of the Sun: the planet sets after the Sun
+	east := normalizeAngle(planet.Longitude - sunLongitude)
+	if planet.Name == string(Moon) {
+		if east < 180 {
+			return PhaseWaxing
+		}
+		return PhaseWaning
+	}
+	if east < 180 {
+		return PhaseOccidental
+	}
+	return PhaseOriental
+}
+
+// GetSolarCondition returns the planet's condition with respect to the Sun's beams
+func GetSolarCondition(planet Planet, sunLongitude float64) string {
+	distance := AngularDistance(planet.Longitude, sunLongitude)
+	switch {
+	case distance <= CazimiOrb:
+		return SolarCazimi
+	case planet.IsCombust(sunLongitude):
+		return SolarCombust
+	case distance <= UnderBeamsOrb:
+		return SolarUnderBeams
+	default:
+		return SolarFree
+	}
+}
+
+// GetPlanetSect returns the sect of a traditional planet. Mercury is diurnal
+// when oriental and nocturnal when occidental.
+func GetPlanetSect(planet Planet, sunLongitude float64) string {
+	switch planet.Name {
+	case string(Sun), string(Jupiter), string(Saturn):
+		return SectDiurnal
+	case string(Moon), string(Venus), string(Mars):
+		return SectNocturnal
+	case string(Mercury):
+		if GetSolarPhase(planet, sunLongitude) == PhaseOriental {
+			return SectDiurnal
+		}
+		return SectNocturnal
+	}
+	return ""
+}
+
+// NewAccidentalDignity analyses the accidental condition of a planet
+func NewAccidentalDignity(planet Planet, sunLongitude float64, isNight bool) AccidentalDignity {
+	accidental := AccidentalDignity{
+		Planet:     planet.Name,
+		House:      planet.House,
+		Angularity: GetHouseAngularity(planet.House),
+		InJoy:      planet.House != 0 && GetPlanetaryJoy(planet.Name) == planet.House,
+		Sect:       GetPlanetSect(planet, sunLongitude),
+	}
+
+	if planet.Name != string(Sun) {
+		accidental.SolarCondition = GetSolarCondition(planet, sunLongitude)
+		accidental.SolarPhase = GetSolarPhase(planet, sunLongitude)
+	}
+
+	// Sect and hayz
+	chartSect := SectDiurnal
+	if isNight {
+		chartSect = SectNocturnal
+	}
+	if accidental.Sect != "" {
+		accidental.InSect = accidental.Sect == chartSect
+		aboveHorizon := planet.House >= 7 && planet.House <= 12
+		masculineSign := GetPolarityForSign(planet.Sign) == "positive"
+		sectGender := masculineSign == (accidental.Sect == SectDiurnal)
+		accidental.Hayz = accidental.InSect && aboveHorizon && sectGender
+	}
+
+	// Speed relative to mean motion
+	if mean := GetMeanDailyMotion(planet.Name); mean > 0 {
+		accidental.SpeedRatio = math.Abs(planet.Speed) / mean
+		switch {
+		case accidental.SpeedRatio < stationaryRatio:
+			accidental.Motion = MotionStationary
+		case planet.Speed < 0:
+			accidental.Motion = MotionRetrograde
+		case accidental.SpeedRatio >= 1:
+			accidental.Motion = MotionFast
+		default:
+			accidental.Motion = MotionSlow
+		}
+	}
+
+	accidental.Score = accidental.calculateScore()
+	return accidental
+}
+
+// calculateScore adds up the accidental fortitudes and debilities
+func (a AccidentalDignity) calculateScore() int {
+	score := 0
+
+	switch a.House {
+	case 1, 10:
+		score += AngularPoints
+	case 4, 7, 11:
+		score += CardinalHousePoints
+	case 2, 5:
+		score += SuccedentPoints
+	case 9:
+		score += NinthHousePoints
+	case 3:
+		score += ThirdHousePoints
+	case 12:
+		score += TwelfthHousePoints
+	case 6, 8:
+		score += EvilHousePoints
+	}
+
+	switch a.Motion {
+	case MotionRetrograde:
+		score += RetrogradePoints
+	case MotionFast:
+		score += DirectPoints + FastPoints
+	case MotionSlow:
+		score += DirectPoints + SlowPoints
+	}
+
+	switch a.SolarCondition {
+	case SolarCazimi:
+		score += CazimiPoints
+	case SolarCombust:
+		score += CombustPoints
+	case SolarUnderBeams:
+		score += UnderBeamsPoints
+	case SolarFree:
+		score += FreeFromSunPoints
+	}
+
+	switch a.Planet {
+	case string(Mars), string(Jupiter), string(Saturn):
+		score += a.phasePoints(PhaseOriental)
+	case string(Mercury), string(Venus):
+		score += a.phasePoints(PhaseOccidental)
+	case string(Moon):
+		score += a.phasePoints(PhaseWaxing)
+	}
+
+	if a.InJoy {
+		score += JoyPoints
+	}
+	if a.InSect {
+		score += InSectPoints
+	}
+	if a.Hayz {
+		score += HayzPoints
+	}
+
+	return score
+}
+
+// phasePoints scores the solar phase against the phase that strengthens the planet
+func (a AccidentalDignity) phasePoints(favorable string) int {
+	if a.SolarPhase == favorable {
+		return SolarPhasePoints
+	}
+	return -SolarPhasePoints
+}
+
+// NewPlanetaryStrength adds the essential and accidental scores of a planet
+func NewPlanetaryStrength(essential EssentialDignity, accidental AccidentalDignity) PlanetaryStrength {
+	return PlanetaryStrength{
+		Planet:          accidental.Planet,
+		DignityScore:    essential.Score,
+		AccidentalScore: accidental.Score,
+		OverallScore:    essential.Score + accidental.Score,
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+// AntisciaType represents the kind of solstice/equinox reflection
+type AntisciaType string
+
+const (
+	Antiscion       AntisciaType = "antiscion"        // Reflection across the Cancer/Capricorn (solstice) axis
+	ContraAntiscion AntisciaType = "contra_antiscion" // Reflection across the Aries/Libra (equinox) axis
+)
+
+// AntisciaPoint holds the antiscion and contra-antiscion of a planet or angle
+type AntisciaPoint struct {
+	Name                  string  `json:"name"`
+	Longitude             float64 `json:"longitude"`
+	Antiscion             float64 `json:"antiscion"`
+	AntiscionSign         string  `json:"antiscion_sign"`
+	AntiscionDegree       string  `json:"antiscion_degree"`
+	ContraAntiscion       float64 `json:"contra_antiscion"`
+	ContraAntiscionSign   string  `json:"contra_antiscion_sign"`
+	ContraAntiscionDegree string  `json:"contra_antiscion_degree"`
+}
+
+// AntisciaAspect represents a point falling on another point's antiscion or contra-antiscion
+type AntisciaAspect struct {
+	Planet1 string       `json:"planet1"`
+	Planet2 string       `json:"planet2"`
+	Type    AntisciaType `json:"type"`
+	Orb     float64      `json:"orb"`
+}
+
+// CalculateAntiscion reflects a longitude across the 0° Cancer / 0° Capricorn axis
+func CalculateAntiscion(longitude float64) float64 {
+	return normalizeAngle(180 - longitude)
+}
+
+// CalculateContraAntiscion reflects a longitude across the 0° Aries / 0° Libra axis
+func CalculateContraAntiscion(longitude float64) float64 {
+	return normalizeAngle(360 - longitude)
+}
+
+// NewAntisciaPoint calculates the antiscia of a named longitude
+func NewAntisciaPoint(name string, longitude float64) AntisciaPoint {
+	antiscion := CalculateAntiscion(longitude)
+	contraAntiscion := CalculateContraAntiscion(longitude)
+
+	return AntisciaPoint{
+		Name:                  name,
+		Longitude:             longitude,
+		Antiscion:             antiscion,
+		AntiscionSign:         GetZodiacSign(antiscion),
+		AntiscionDegree:       FormatDegreeInSign(antiscion),
+		ContraAntiscion:       contraAntiscion,
+		ContraAntiscionSign:   GetZodiacSign(contraAntiscion),
+		ContraAntiscionDegree: FormatDegreeInSign(contraAntiscion),
+	}
+}
+
+// NewAntisciaAspect returns the antiscia contact between two points, or nil if out of orb
+func NewAntisciaAspect(point1, point2 AntisciaPoint, orb float64) *AntisciaAspect {
+	if distance := AngularDistance(point1.Antiscion, point2.Longitude); distance <= orb {
+		return &AntisciaAspect{Planet1: point1.Name, Planet2: point2.Name, Type: Antiscion, Orb: distance}
+	}
+
+	if distance := AngularDistance(point1.ContraAntiscion, point2.Longitude); distance <= orb {
+		return &AntisciaAspect{Planet1: point1.Name, Planet2: point2.Name, Type: ContraAntiscion, Orb: distance}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package domain
+
+// Ashtakavarga holds the Bhinna (per planet) and Sarva (combined) ashtakavarga tables
+type Ashtakavarga struct {
+	Bhinna []BhinnaAshtakavarga `json:"bhinna"`
+	Sarva  []AshtakavargaSign   `json:"sarva"`
+	Total  int                  `json:"total"` // Always 337 for a complete chart
+}
+
+// BhinnaAshtakavarga holds the bindus contributed to one planet's ashtakavarga
+type BhinnaAshtakavarga struct {
+	Planet string             `json:"planet"`
+	Signs  []AshtakavargaSign `json:"signs"`
+	Total  int                `json:"total"`
+}
+
+// AshtakavargaSign holds the bindus of a single sign
+type AshtakavargaSign struct {
+	Sign         string   `json:"sign"`
+	Bindus       int      `json:"bindus"`
+	Contributors []string `json:"contributors,omitempty"` // Reference points giving a bindu (Bhinna only)
+}
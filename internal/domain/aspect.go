@@ -1,6 +1,13 @@
 package domain
 
-import "math"
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
 
 // AspectType represents the type of aspect
 type AspectType string
@@ -15,6 +22,18 @@ const (
 	AspectSemisextile  AspectType = "semisextile"
 	AspectSemisquare   AspectType = "semisquare"
 	AspectSesquisquare AspectType = "sesquisquare"
+
+	// Harmonic aspects of the 5th, 7th, 9th and 10th harmonics
+	AspectQuintile   AspectType = "quintile"
+	AspectBiquintile AspectType = "biquintile"
+	AspectSeptile    AspectType = "septile"
+	AspectBiseptile  AspectType = "biseptile"
+	AspectTriseptile AspectType = "triseptile"
+	AspectNovile     AspectType = "novile"
+	AspectBinovile   AspectType = "binovile"
+	AspectQuadnovile AspectType = "quadnovile"
+	AspectDecile     AspectType = "decile"
+	AspectTredecile  AspectType = "tredecile"
 )
 
 // Aspect represents an astrological aspect between two celestial bodies
@@ -24,12 +43,20 @@ type Aspect struct {
 	Type       AspectType `json:"type"`
 	Angle      float64    `json:"angle"`       // Exact angle between planets
 	Orb        float64    `json:"orb"`         // Deviation from exact aspect
-	IsApplying bool       `json:"is_applying"` // Whether aspect is applying or separating
-	IsExact    bool       `json:"is_exact"`    // Whether aspect is exact (orb < 1 degree)
+	IsApplying bool       `json:"is_applying"` // Whether the relative motion is closing the orb
+	IsExact    bool       `json:"is_exact"`    // Whether the orb is within ExactAspectOrb
 	Strength   float64    `json:"strength"`    // Aspect strength (0-1, based on orb)
 	Nature     string     `json:"nature"`      // harmonious, challenging, neutral
+	Symbol     string     `json:"symbol"`
+	Color      string     `json:"color,omitempty"` // chart color of custom aspects
+
+	DaysToExact *float64   `json:"days_to_exact,omitempty"` // Days until (positive) or since (negative) exactitude at the current relative speed
+	ExactDate   *time.Time `json:"exact_date,omitempty"`    // Estimated moment of exactitude, when within the requested window
 }
 
+// ExactAspectOrb is the orb below which an aspect is considered exact (10 arc minutes)
+const ExactAspectOrb = 1.0 / 6
+
 // AspectDefinition defines the properties of an aspect type
 type AspectDefinition struct {
 	Type        AspectType
@@ -38,10 +65,108 @@ type AspectDefinition struct {
 	Symbol      string
 	Nature      string // harmonious, challenging, neutral
 	Description string
+	Color       string // optional chart color (#rrggbb) for custom aspects
 }
 
-// GetAspectDefinitions returns all standard aspect definitions
+// CustomAspect is a user-defined aspect given in a request or in the custom aspects file
+type CustomAspect struct {
+	Name   string  `json:"name"`
+	Angle  float64 `json:"angle"`
+	Orb    float64 `json:"orb,omitempty"`    // defaults to 1
+	Nature string  `json:"nature,omitempty"` // harmonious, challenging or neutral (defaults to neutral)
+	Symbol string  `json:"symbol,omitempty"` // defaults to the first letters of the name
+	Color  string  `json:"color,omitempty"`  // chart color as #rrggbb
+}
+
+// hexColorPattern matches #rgb and #rrggbb colors
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Custom aspects registered at startup, shared by all calculations
+var (
+	registeredAspectsMu sync.RWMutex
+	registeredAspects   []AspectDefinition
+)
+
+// ToDefinition validates a custom aspect and converts it to an aspect definition
+func (ca CustomAspect) ToDefinition() (AspectDefinition, error) {
+	name := strings.ToLower(strings.TrimSpace(ca.Name))
+	if name == "" {
+		return AspectDefinition{}, fmt.Errorf("custom aspect name is required")
+	}
+
+	if ca.Angle <= 0 || ca.Angle > 180 {
+		return AspectDefinition{}, fmt.Errorf("angle of custom aspect %s must be greater than 0 and at most 180", name)
+	}
+
+	orb := ca.Orb
+	if orb == 0 {
+		orb = 1
+	}
+	if orb < 0 || orb > 10 {
+		return AspectDefinition{}, fmt.Errorf("orb of custom aspect %s must be between 0 and 10 degrees", name)
+	}
+
+	nature := ca.Nature
+	switch nature {
+	case "":
+		nature = "neutral"
+	case "harmonious", "challenging", "neutral":
+	default:
+		return AspectDefinition{}, fmt.Errorf("nature of custom aspect %s must be harmonious, challenging or neutral", name)
+	}
+
+	if ca.Color != "" && !hexColorPattern.MatchString(ca.Color) {
+		return AspectDefinition{}, fmt.Errorf("color of custom aspect %s must be a hex color such as #8e44ad", name)
+	}
+
+	symbol := strings.TrimSpace(ca.Symbol)
+	if len([]rune(symbol)) > 4 || strings.ContainsAny(symbol, "<>&\"'") {
+		return AspectDefinition{}, fmt.Errorf("symbol of custom aspect %s must be at most 4 characters without markup", name)
+	}
+	if symbol == "" {
+		label := []rune(name)
+		if len(label) > 2 {
+			label = label[:2]
+		}
+		symbol = strings.ToUpper(string(label[:1])) + string(label[1:])
+	}
+
+	return AspectDefinition{
+		Type:        AspectType(name),
+		Angle:       ca.Angle,
+		BaseOrb:     orb,
+		Symbol:      symbol,
+		Nature:      nature,
+		Description: fmt.Sprintf("Custom aspect of %g°", ca.Angle),
+		Color:       ca.Color,
+	}, nil
+}
+
+// RegisterAspectDefinition adds a custom aspect to the definitions returned by GetAspectDefinitions
+func RegisterAspectDefinition(def AspectDefinition) error {
+	registeredAspectsMu.Lock()
+	defer registeredAspectsMu.Unlock()
+
+	for _, existing := range append(getStandardAspectDefinitions(), registeredAspects...) {
+		if existing.Type == def.Type {
+			return fmt.Errorf("aspect %s is already defined", def.Type)
+		}
+	}
+	registeredAspects = append(registeredAspects, def)
+
+	return nil
+}
+
+// GetAspectDefinitions returns the standard aspect definitions followed by any registered custom aspects
 func GetAspectDefinitions() []AspectDefinition {
+	registeredAspectsMu.RLock()
+	defer registeredAspectsMu.RUnlock()
+
+	return append(getStandardAspectDefinitions(), registeredAspects...)
+}
+
+// getStandardAspectDefinitions returns the built-in aspect definitions
+func getStandardAspectDefinitions() []AspectDefinition {
 	return []AspectDefinition{
 		{
 			Type:        AspectConjunction,
@@ -115,6 +240,86 @@ func GetAspectDefinitions() []AspectDefinition {
 			Nature:      "challenging",
 			Description: "Minor tension, adjustment",
 		},
+		{
+			Type:        AspectQuintile,
+			Angle:       72,
+			BaseOrb:     2,
+			Symbol:      "Q",
+			Nature:      "harmonious",
+			Description: "Creativity, talent, style",
+		},
+		{
+			Type:        AspectBiquintile,
+			Angle:       144,
+			BaseOrb:     2,
+			Symbol:      "bQ",
+			Nature:      "harmonious",
+			Description: "Creative expression, skill",
+		},
+		{
+			Type:        AspectSeptile,
+			Angle:       360.0 / 7,
+			BaseOrb:     1,
+			Symbol:      "S",
+			Nature:      "neutral",
+			Description: "Inspiration, fate, calling",
+		},
+		{
+			Type:        AspectBiseptile,
+			Angle:       720.0 / 7,
+			BaseOrb:     1,
+			Symbol:      "bS",
+			Nature:      "neutral",
+			Description: "Inner vision, compulsion",
+		},
+		{
+			Type:        AspectTriseptile,
+			Angle:       1080.0 / 7,
+			BaseOrb:     1,
+			Symbol:      "tS",
+			Nature:      "neutral",
+			Description: "Devotion, irrational drive",
+		},
+		{
+			Type:        AspectNovile,
+			Angle:       40,
+			BaseOrb:     1,
+			Symbol:      "N",
+			Nature:      "harmonious",
+			Description: "Completion, spiritual joy",
+		},
+		{
+			Type:        AspectBinovile,
+			Angle:       80,
+			BaseOrb:     1,
+			Symbol:      "bN",
+			Nature:      "harmonious",
+			Description: "Maturing, integration",
+		},
+		{
+			Type:        AspectQuadnovile,
+			Angle:       160,
+			BaseOrb:     1,
+			Symbol:      "qN",
+			Nature:      "harmonious",
+			Description: "Mastery through effort",
+		},
+		{
+			Type:        AspectDecile,
+			Angle:       36,
+			BaseOrb:     1,
+			Symbol:      "D",
+			Nature:      "neutral",
+			Description: "Skill, opportunity through effort",
+		},
+		{
+			Type:        AspectTredecile,
+			Angle:       108,
+			BaseOrb:     1,
+			Symbol:      "tD",
+			Nature:      "neutral",
+			Description: "Talent, creative tension",
+		},
 	}
 }
 
@@ -129,46 +334,65 @@ func GetAspectDefinition(aspectType AspectType) *AspectDefinition {
 	return nil
 }
 
-// NewAspect creates a new aspect between two planets
-func NewAspect(planet1, planet2 string, planet1Lon, planet2Lon, planet1Speed, planet2Speed float64) *Aspect {
-	// Calculate the angular difference
+// NewAspectWithOrb creates an aspect of the given definition if the two planets are within maxOrb of it.
+// Strength falls linearly from 1 at exactitude to 0 at maxOrb.
+func NewAspectWithOrb(planet1, planet2 string, planet1Lon, planet2Lon, planet1Speed, planet2Speed float64, def AspectDefinition, maxOrb float64) *Aspect {
 	angle := AngularDistance(planet1Lon, planet2Lon)
-
-	// Find the best matching aspect
-	aspectType, exactAngle, orb := FindBestAspect(angle)
-	if aspectType == "" {
-		return nil // No aspect found within orb
+	orb := math.Abs(angle - def.Angle)
+	if maxOrb <= 0 || orb > maxOrb {
+		return nil
 	}
 
-	// Determine if aspect is applying or separating
-	// Applying means faster planet is moving toward exact aspect
-	isApplying := IsAspectApplying(planet1Lon, planet2Lon, planet1Speed, planet2Speed, exactAngle)
-
-	// Calculate aspect strength based on orb
-	def := GetAspectDefinition(aspectType)
-	strength := 1.0
-	if def != nil && def.BaseOrb > 0 {
-		strength = 1.0 - (orb / def.BaseOrb)
-		if strength < 0 {
-			strength = 0
-		}
+	// The aspect applies while the relative motion of the two bodies closes the orb,
+	// whichever body is faster or retrograde
+	orbRate := AspectOrbRate(planet1Lon, planet2Lon, planet1Speed, planet2Speed, def.Angle)
+
+	strength := 1.0 - (orb / maxOrb)
+	if strength < 0 {
+		strength = 0
 	}
 
 	aspect := &Aspect{
 		Planet1:    planet1,
 		Planet2:    planet2,
-		Type:       aspectType,
+		Type:       def.Type,
 		Angle:      angle,
 		Orb:        orb,
-		IsApplying: isApplying,
-		IsExact:    orb < 1.0,
+		IsApplying: orbRate < 0,
+		IsExact:    orb < ExactAspectOrb,
 		Strength:   strength,
 		Nature:     def.Nature,
+		Symbol:     def.Symbol,
+		Color:      def.Color,
+	}
+
+	// Time to exactitude, assuming the current relative speed holds
+	if orbRate != 0 {
+		days := -orb / orbRate
+		aspect.DaysToExact = &days
 	}
 
 	return aspect
 }
 
+// SetExactDate sets the estimated moment of exactitude from the chart time when it falls
+// within windowDays before or after it
+func (a *Aspect) SetExactDate(chartTime time.Time, windowDays float64) {
+	a.ExactDate = nil
+	if a.DaysToExact == nil || math.Abs(*a.DaysToExact) > windowDays {
+		return
+	}
+
+	exactDate := chartTime.Add(time.Duration(*a.DaysToExact * 24 * float64(time.Hour)))
+	a.ExactDate = &exactDate
+}
+
+// ClearTiming removes the time to exactitude, for aspects between charts cast for different moments
+func (a *Aspect) ClearTiming() {
+	a.DaysToExact = nil
+	a.ExactDate = nil
+}
+
 // FindBestAspect finds the best matching aspect for a given angle
 func FindBestAspect(angle float64) (AspectType, float64, float64) {
 	definitions := GetAspectDefinitions()
@@ -201,17 +425,29 @@ func FindBestAspect(angle float64) (AspectType, float64, float64) {
 
 // IsAspectApplying determines if an aspect is applying (getting closer) or separating
 func IsAspectApplying(lon1, lon2, speed1, speed2, exactAngle float64) bool {
-	// Calculate the current angular difference
-	currentAngle := AngularDistance(lon1, lon2)
+	return AspectOrbRate(lon1, lon2, speed1, speed2, exactAngle) < 0
+}
 
-	// Calculate what the angle will be in one day
-	futureAngle := AngularDistance(lon1+speed1, lon2+speed2)
+// AspectOrbRate returns the daily change of the orb of an aspect from the relative motion
+// of the two bodies. It is negative while the aspect is applying and positive while it separates.
+func AspectOrbRate(lon1, lon2, speed1, speed2, exactAngle float64) float64 {
+	// Signed separation from body 1 to body 2 in (-180, 180]
+	separation := normalizeAngle(lon2 - lon1)
+	if separation > 180 {
+		separation -= 360
+	}
 
-	// If future angle is closer to exact aspect angle, it's applying
-	currentDiff := math.Abs(currentAngle - exactAngle)
-	futureDiff := math.Abs(futureAngle - exactAngle)
+	// The angular distance grows when the separation moves away from 0
+	distanceRate := speed2 - speed1
+	if separation < 0 {
+		distanceRate = -distanceRate
+	}
 
-	return futureDiff < currentDiff
+	// The orb grows when the distance moves away from the exact angle
+	if math.Abs(separation) < exactAngle {
+		return -distanceRate
+	}
+	return distanceRate
 }
 
 // IsHarmoniousAspect returns true if the aspect is generally harmonious
@@ -254,6 +490,9 @@ func (a Aspect) IsMinorAspect() bool {
 
 // GetSymbol returns the symbol for the aspect
 func (a Aspect) GetSymbol() string {
+	if a.Symbol != "" {
+		return a.Symbol
+	}
 	def := GetAspectDefinition(a.Type)
 	if def != nil {
 		return def.Symbol
@@ -269,32 +508,3 @@ func (a Aspect) GetDescription() string {
 	}
 	return ""
 }
-
-// CalculateAspects calculates all aspects between a list of planets
-func CalculateAspects(planets []Planet) []Aspect {
-	var aspects []Aspect
-
-	// Compare each planet with every other planet
-	for i, planet1 := range planets {
-		for j, planet2 := range planets {
-			if i >= j {
-				continue // Avoid duplicate pairs and self-aspects
-			}
-
-			aspect := NewAspect(
-				planet1.Name,
-				planet2.Name,
-				planet1.Longitude,
-				planet2.Longitude,
-				planet1.Speed,
-				planet2.Speed,
-			)
-
-			if aspect != nil {
-				aspects = append(aspects, *aspect)
-			}
-		}
-	}
-
-	return aspects
-}
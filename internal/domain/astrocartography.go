@@ -0,0 +1,68 @@
+package domain
+
+// ACGAngle represents the angle a planet occupies along an astrocartography line
+type ACGAngle string
+
+const (
+	ACGMidheaven  ACGAngle = "MC"  // Culminating
+	ACGIC         ACGAngle = "IC"  // Anti-culminating
+	ACGAscendant  ACGAngle = "ASC" // Rising
+	ACGDescendant ACGAngle = "DSC" // Setting
+)
+
+// GetACGAngles returns the four astrocartography angles
+func GetACGAngles() []ACGAngle {
+	return []ACGAngle{ACGMidheaven, ACGIC, ACGAscendant, ACGDescendant}
+}
+
+// GeoPoint is a geographic position in degrees, east longitudes positive
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ACGLine is the set of places where a planet is on an angle at the chart moment.
+// A line is split into segments where it leaves the map, at the antimeridian or
+// where the planet never rises or sets near the poles.
+type ACGLine struct {
+	Planet   string       `json:"planet"`
+	Angle    ACGAngle     `json:"angle"`
+	Segments [][]GeoPoint `json:"segments"`
+}
+
+// ACGLineDistance is the distance from a place to the nearest point of a line
+type ACGLineDistance struct {
+	Planet     string   `json:"planet"`
+	Angle      ACGAngle `json:"angle"`
+	DistanceKm float64  `json:"distance_km"`
+	Nearest    GeoPoint `json:"nearest"` // Closest point of the line
+}
+
+// ACGParan is a latitude where two bodies are angular at the same moment of the day,
+// one on the meridian or horizon and the other on another angle
+type ACGParan struct {
+	Planet1  string   `json:"planet1"`
+	Angle1   ACGAngle `json:"angle1"`
+	Planet2  string   `json:"planet2"`
+	Angle2   ACGAngle `json:"angle2"`
+	Latitude float64  `json:"latitude"`
+}
+
+// LocalSpacePlanet is the direction of a planet seen from the birth place. Its local space
+// line is the great circle leaving the birth place with the planet's azimuth as bearing.
+type LocalSpacePlanet struct {
+	Planet    string           `json:"planet"`
+	Azimuth   float64          `json:"azimuth"`   // Degrees from north through east
+	Altitude  float64          `json:"altitude"`  // Degrees above (+) or below (-) the horizon
+	Direction string           `json:"direction"` // Compass direction, e.g. "NE"
+	Cities    []LocalSpaceCity `json:"cities,omitempty"`
+}
+
+// LocalSpaceCity is a city lying along a local space line
+type LocalSpaceCity struct {
+	City       string  `json:"city"`
+	Country    string  `json:"country"`
+	Bearing    float64 `json:"bearing"` // Initial great-circle bearing from the birth place
+	DistanceKm float64 `json:"distance_km"`
+	Opposite   bool    `json:"opposite"` // On the opposite end of the line, 180° from the azimuth
+}
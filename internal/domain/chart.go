@@ -15,24 +15,34 @@ const (
 	ChartTypeLunarReturn  ChartType = "lunar_return"
 	ChartTypeProgressions ChartType = "progressions"
 	ChartTypeTransits     ChartType = "transits"
+	ChartTypeHarmonic     ChartType = "harmonic"
 )
 
 // Chart represents a complete astrological chart
 type Chart struct {
-	ID          string      `json:"id"`
-	Type        ChartType   `json:"type"`
-	Name        string      `json:"name"`
-	Description string      `json:"description,omitempty"`
-	BirthInfo   BirthInfo   `json:"birth_info"`
-	Planets     []Planet    `json:"planets"`
-	Houses      []House     `json:"houses"`
-	Aspects     []Aspect    `json:"aspects"`
-	Angles      ChartAngles `json:"angles"`
-	HouseSystem string      `json:"house_system"`
-	Timezone    string      `json:"timezone"`
-	UTCTime     time.Time   `json:"utc_time"`
-	ChartDraw   string      `json:"chart_draw,omitempty"` // SVG chart
-	CreatedAt   time.Time   `json:"created_at"`
+	ID                 string              `json:"id"`
+	Type               ChartType           `json:"type"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	BirthInfo          BirthInfo           `json:"birth_info"`
+	Planets            []Planet            `json:"planets"`
+	Houses             []House             `json:"houses"`
+	Aspects            []Aspect            `json:"aspects"`
+	Lots               []Lot               `json:"lots,omitempty"`                // Arabic parts / Hellenistic lots
+	DeclinationAspects []DeclinationAspect `json:"declination_aspects,omitempty"` // Parallels and contraparallels
+	Antiscia           []AntisciaPoint     `json:"antiscia,omitempty"`            // Antiscia of planets and angles
+	AntisciaAspects    []AntisciaAspect    `json:"antiscia_aspects,omitempty"`    // Points on another point's antiscia
+	Patterns           []AspectPattern     `json:"patterns"`                      // Aspect patterns and stelliums
+	Dignities          *DignityReport      `json:"dignities,omitempty"`           // Essential dignities and almutens
+	Dispositors        *DispositorReport   `json:"dispositors,omitempty"`         // Dispositor chains, loops and mutual receptions
+	Overview           *ChartOverview      `json:"overview,omitempty"`            // Shape, hemispheres, balances and chart ruler
+	Angles             ChartAngles         `json:"angles"`
+	HouseSystem        string              `json:"house_system"`
+	Timezone           string              `json:"timezone"`
+	UTCTime            time.Time           `json:"utc_time"`
+	Warnings           []string            `json:"warnings,omitempty"`   // Fallbacks applied during the calculation
+	ChartDraw          string              `json:"chart_draw,omitempty"` // SVG chart
+	CreatedAt          time.Time           `json:"created_at"`
 }
 
 // ChartAngles represents the main chart angles
@@ -41,13 +51,23 @@ type ChartAngles struct {
 	Midheaven  ChartAngle `json:"midheaven"`
 	IC         ChartAngle `json:"ic,omitempty"`
 	Descendant ChartAngle `json:"descendant,omitempty"`
+
+	// Sensitive points, set when requested
+	ARMC                float64     `json:"armc,omitempty"` // Right ascension of the Midheaven
+	Vertex              *ChartAngle `json:"vertex,omitempty"`
+	AntiVertex          *ChartAngle `json:"anti_vertex,omitempty"`
+	EastPoint           *ChartAngle `json:"east_point,omitempty"` // Equatorial Ascendant
+	CoAscendantKoch     *ChartAngle `json:"co_ascendant_koch,omitempty"`
+	CoAscendantMunkasey *ChartAngle `json:"co_ascendant_munkasey,omitempty"`
+	PolarAscendant      *ChartAngle `json:"polar_ascendant,omitempty"`
 }
 
 // ChartAngle represents an important chart angle
 type ChartAngle struct {
 	Sign   string  `json:"sign"`
 	Degree string  `json:"degree"`
-	Value  float64 `json:"value"` // Raw degree value
+	Value  float64 `json:"value"`           // Raw degree value
+	Speed  float64 `json:"speed,omitempty"` // Daily motion in degrees
 }
 
 // BirthInfo represents birth information for a chart
@@ -67,6 +87,7 @@ func NewChart(chartType ChartType, name string, birthInfo BirthInfo) *Chart {
 		Planets:   make([]Planet, 0),
 		Houses:    make([]House, 0),
 		Aspects:   make([]Aspect, 0),
+		Patterns:  make([]AspectPattern, 0),
 	}
 }
 
@@ -116,6 +137,36 @@ func (c *Chart) SetAngles(ascendant, midheaven float64) {
 	}
 }
 
+// NewChartAngle creates a chart angle from its longitude and daily motion
+func NewChartAngle(value, speed float64) *ChartAngle {
+	return &ChartAngle{
+		Sign:   GetZodiacSign(value),
+		Degree: FormatDegreeInSign(value),
+		Value:  value,
+		Speed:  speed,
+	}
+}
+
+// SetSensitivePoints sets the ARMC, the Vertex and Anti-Vertex, the East Point,
+// the co-ascendants and the polar ascendant
+func (c *Chart) SetSensitivePoints(armc float64, vertex, eastPoint, coAscendantKoch, coAscendantMunkasey, polarAscendant *ChartAngle) {
+	c.Angles.ARMC = armc
+	c.Angles.Vertex = vertex
+	c.Angles.AntiVertex = NewChartAngle(normalizeAngle(vertex.Value+180), vertex.Speed)
+	c.Angles.EastPoint = eastPoint
+	c.Angles.CoAscendantKoch = coAscendantKoch
+	c.Angles.CoAscendantMunkasey = coAscendantMunkasey
+	c.Angles.PolarAscendant = polarAscendant
+}
+
+// SetAngleSpeeds sets the daily motion of the angles
+func (c *Chart) SetAngleSpeeds(ascendantSpeed, midheavenSpeed float64) {
+	c.Angles.Ascendant.Speed = ascendantSpeed
+	c.Angles.Descendant.Speed = ascendantSpeed
+	c.Angles.Midheaven.Speed = midheavenSpeed
+	c.Angles.IC.Speed = midheavenSpeed
+}
+
 // GetPlanetByName returns a planet by its name
 func (c *Chart) GetPlanetByName(name string) *Planet {
 	for i, planet := range c.Planets {
@@ -135,3 +186,17 @@ func (c *Chart) GetHouseByNumber(number int) *House {
 	}
 	return nil
 }
+
+// AddLot adds a lot to the chart
+func (c *Chart) AddLot(lot Lot) {
+	c.Lots = append(c.Lots, lot)
+}
+
+// GetLotPoints returns the chart lots as points for aspect calculations
+func (c *Chart) GetLotPoints() []Planet {
+	var points []Planet
+	for _, lot := range c.Lots {
+		points = append(points, lot.ToPlanet())
+	}
+	return points
+}
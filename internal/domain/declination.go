@@ -0,0 +1,50 @@
+package domain
+
+import "math"
+
+// DeclinationAspectType represents the type of declination aspect
+type DeclinationAspectType string
+
+const (
+	AspectParallel       DeclinationAspectType = "parallel"       // Same declination, same hemisphere
+	AspectContraparallel DeclinationAspectType = "contraparallel" // Same declination, opposite hemispheres
+)
+
+// DeclinationAspect represents a parallel or contraparallel between two bodies
+type DeclinationAspect struct {
+	Planet1      string                `json:"planet1"`
+	Planet2      string                `json:"planet2"`
+	Type         DeclinationAspectType `json:"type"`
+	Declination1 float64               `json:"declination1"`
+	Declination2 float64               `json:"declination2"`
+	Orb          float64               `json:"orb"`
+}
+
+// NewDeclinationAspect returns the parallel or contraparallel between two declinations, or nil if out of orb
+func NewDeclinationAspect(planet1, planet2 string, declination1, declination2, orb float64) *DeclinationAspect {
+	aspect := &DeclinationAspect{
+		Planet1:      planet1,
+		Planet2:      planet2,
+		Declination1: declination1,
+		Declination2: declination2,
+	}
+
+	if difference := math.Abs(declination1 - declination2); difference <= orb {
+		aspect.Type = AspectParallel
+		aspect.Orb = difference
+		return aspect
+	}
+
+	if difference := math.Abs(declination1 + declination2); difference <= orb {
+		aspect.Type = AspectContraparallel
+		aspect.Orb = difference
+		return aspect
+	}
+
+	return nil
+}
+
+// IsOutOfBounds returns true if a declination exceeds the Sun's greatest declination (the obliquity)
+func IsOutOfBounds(declination, obliquity float64) bool {
+	return math.Abs(declination) > obliquity
+}
@@ -0,0 +1,368 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+// Rulership schemes for sign rulers
+const (
+	RulershipTraditional = "traditional" // seven visible planets only
+	RulershipModern      = "modern"      // Uranus, Neptune and Pluto co-rule Aquarius, Pisces and Scorpio
+)
+
+// Triplicity ruler schemes
+const (
+	TriplicityDorothean = "dorothean" // day, night and participating rulers
+	TriplicityLilly     = "lilly"     // day and night rulers, Mars rules water by day and night
+)
+
+// Term (bounds) schemes
+const (
+	TermsEgyptian  = "egyptian"
+	TermsPtolemaic = "ptolemaic"
+)
+
+// Essential dignity points (after Lilly)
+const (
+	DomicilePoints   = 5
+	ExaltationPoints = 4
+	TriplicityPoints = 3
+	TermPoints       = 2
+	FacePoints       = 1
+	DetrimentPoints  = -5
+	FallPoints       = -4
+	PeregrinePoints  = -5
+)
+
+// DignitySettings selects the rulership, triplicity and term tables
+type DignitySettings struct {
+	Rulership    string `json:"rulership"`    // "traditional" or "modern"
+	Triplicities string `json:"triplicities"` // "dorothean" or "lilly"
+	Terms        string `json:"terms"`        // "egyptian" or "ptolemaic"
+}
+
+// EssentialDignity is the point-scored dignity table entry of a planet
+type EssentialDignity struct {
+	Planet     string `json:"planet"`
+	Sign       string `json:"sign"`
+	Degree     string `json:"degree"`
+	Domicile   bool   `json:"domicile"`
+	Exaltation bool   `json:"exaltation"`
+	Triplicity bool   `json:"triplicity"`
+	Term       bool   `json:"term"`
+	Face       bool   `json:"face"`
+	Detriment  bool   `json:"detriment"`
+	Fall       bool   `json:"fall"`
+	Peregrine  bool   `json:"peregrine"` // No essential dignity of any kind
+	Score      int    `json:"score"`
+
+	// Rulers of the planet's degree
+	DomicileRuler    string   `json:"domicile_ruler"`
+	ExaltationRuler  string   `json:"exaltation_ruler,omitempty"`
+	TriplicityRulers []string `json:"triplicity_rulers"` // Ruler by sect first
+	TermRuler        string   `json:"term_ruler"`
+	FaceRuler        string   `json:"face_ruler"`
+}
+
+// DignityReport holds the essential dignities and almutens of a chart
+type DignityReport struct {
+	Settings       DignitySettings     `json:"settings"`
+	IsNightChart   bool                `json:"is_night_chart"`
+	Planets        []EssentialDignity  `json:"planets"`
+	Accidental     []AccidentalDignity `json:"accidental"` // House, solar, sect and motion conditions
+	Strengths      []PlanetaryStrength `json:"strengths"`  // Essential plus accidental score
+	AlmutenFiguris string              `json:"almuten_figuris"`
+	AlmutenScores  map[string]int      `json:"almuten_scores"` // Points of each planet over the hylegical places
+	PrenatalSyzygy float64             `json:"prenatal_syzygy"`
+}
+
+// DefaultDignitySettings returns the default tables: traditional rulers, Dorothean triplicities and Egyptian terms
+func DefaultDignitySettings() DignitySettings {
+	return DignitySettings{
+		Rulership:    RulershipTraditional,
+		Triplicities: TriplicityDorothean,
+		Terms:        TermsEgyptian,
+	}
+}
+
+// Validate checks the dignity settings and fills in the defaults
+func (s *DignitySettings) Validate() error {
+	defaults := DefaultDignitySettings()
+	if s.Rulership == "" {
+		s.Rulership = defaults.Rulership
+	}
+	if s.Triplicities == "" {
+		s.Triplicities = defaults.Triplicities
+	}
+	if s.Terms == "" {
+		s.Terms = defaults.Terms
+	}
+
+	if s.Rulership != RulershipTraditional && s.Rulership != RulershipModern {
+		return fmt.Errorf("invalid rulership: %s (use %q or %q)", s.Rulership, RulershipTraditional, RulershipModern)
+	}
+	if s.Triplicities != TriplicityDorothean && s.Triplicities != TriplicityLilly {
+		return fmt.Errorf("invalid triplicities: %s (use %q or %q)", s.Triplicities, TriplicityDorothean, TriplicityLilly)
+	}
+	if s.Terms != TermsEgyptian && s.Terms != TermsPtolemaic {
+		return fmt.Errorf("invalid terms: %s (use %q or %q)", s.Terms, TermsEgyptian, TermsPtolemaic)
+	}
+	return nil
+}
+
+// GetTraditionalPlanets returns the seven planets that hold essential dignities
+func GetTraditionalPlanets() []string {
+	return []string{
+		string(Sun), string(Moon), string(Mercury), string(Venus),
+		string(Mars), string(Jupiter), string(Saturn),
+	}
+}
+
+// HasEssentialDignities reports whether a body holds essential dignities under a rulership scheme
+func HasEssentialDignities(planetName, rulership string) bool {
+	if containsString(GetTraditionalPlanets(), planetName) {
+		return true
+	}
+	if rulership != RulershipModern {
+		return false
+	}
+	return planetName == string(Uranus) || planetName == string(Neptune) || planetName == string(Pluto)
+}
+
+// GetSignRuler returns the ruler of a sign under the given rulership scheme
+func GetSignRuler(sign, rulership string) string {
+	if rulership == RulershipModern {
+		return GetModernRulerForSign(sign)
+	}
+	return GetRulerForSign(sign)
+}
+
+// GetDomicileRulers returns the planets at home in a sign. With modern rulership the
+// outer planets share Scorpio, Aquarius and Pisces with their traditional rulers.
+func GetDomicileRulers(sign, rulership string) []string {
+	rulers := []string{GetRulerForSign(sign)}
+	if modern := GetModernRulerForSign(sign); rulership == RulershipModern && modern != rulers[0] {
+		rulers = append(rulers, modern)
+	}
+	return rulers
+}
+
+// GetExaltationRuler returns the planet exalted in a sign, or "" if none
+func GetExaltationRuler(sign string) string {
+	exaltations := map[string]string{
+		"Aries":     string(Sun),
+		"Taurus":    string(Moon),
+		"Cancer":    string(Jupiter),
+		"Virgo":     string(Mercury),
+		"Libra":     string(Saturn),
+		"Capricorn": string(Mars),
+		"Pisces":    string(Venus),
+	}
+	return exaltations[sign]
+}
+
+// GetTriplicityRulers returns the triplicity rulers of a sign, the ruler by sect first
+func GetTriplicityRulers(sign, system string, isNight bool) []string {
+	// Day, night and participating rulers per element
+	dorothean := map[string][3]string{
+		"fire":  {string(Sun), string(Jupiter), string(Saturn)},
+		"earth": {string(Venus), string(Moon), string(Mars)},
+		"air":   {string(Saturn), string(Mercury), string(Jupiter)},
+		"water": {string(Venus), string(Mars), string(Moon)},
+	}
+	lilly := map[string][2]string{
+		"fire":  {string(Sun), string(Jupiter)},
+		"earth": {string(Venus), string(Moon)},
+		"air":   {string(Saturn), string(Mercury)},
+		"water": {string(Mars), string(Mars)},
+	}
+
+	element := GetElementForSign(sign)
+	if system == TriplicityLilly {
+		rulers := lilly[element]
+		if isNight {
+			return []string{rulers[1]}
+		}
+		return []string{rulers[0]}
+	}
+
+	rulers := dorothean[element]
+	if isNight {
+		return []string{rulers[1], rulers[0], rulers[2]}
+	}
+	return []string{rulers[0], rulers[1], rulers[2]}
+}
+
+// term is the upper bound (exclusive, in degrees of the sign) of a planet's term
+type term struct {
+	ruler string
+	end   float64
+}
+
+// GetTermRuler returns the ruler of the term (bound) containing a longitude
+func GetTermRuler(longitude float64, system string) string {
+	table := getEgyptianTerms()
+	if system == TermsPtolemaic {
+		table = getPtolemaicTerms()
+	}
+
+	degree := GetDegreeInSign(longitude)
+	for _, t := range table[GetZodiacSign(longitude)] {
+		if degree < t.end {
+			return t.ruler
+		}
+	}
+	return ""
+}
+
+// GetFaceRuler returns the Chaldean face (decan) ruler of a longitude
+func GetFaceRuler(longitude float64) string {
+	chaldean := []string{
+		string(Mars), string(Sun), string(Venus), string(Mercury),
+		string(Moon), string(Saturn), string(Jupiter),
+	}
+	decan := int(math.Floor(normalizeAngle(longitude) / 10))
+	return chaldean[decan%len(chaldean)]
+}
+
+// NewEssentialDignity scores a planet's essential dignities at its longitude
+func NewEssentialDignity(planetName string, longitude float64, isNight bool, settings DignitySettings) EssentialDignity {
+	sign := GetZodiacSign(longitude)
+	dignity := EssentialDignity{
+		Planet:           planetName,
+		Sign:             sign,
+		Degree:           FormatDegreeInSign(longitude),
+		DomicileRuler:    GetSignRuler(sign, settings.Rulership),
+		ExaltationRuler:  GetExaltationRuler(sign),
+		TriplicityRulers: GetTriplicityRulers(sign, settings.Triplicities, isNight),
+		TermRuler:        GetTermRuler(longitude, settings.Terms),
+		FaceRuler:        GetFaceRuler(longitude),
+	}
+
+	dignity.Domicile = containsString(GetDomicileRulers(sign, settings.Rulership), planetName)
+	dignity.Exaltation = dignity.ExaltationRuler == planetName
+	dignity.Triplicity = containsString(dignity.TriplicityRulers, planetName)
+	dignity.Term = dignity.TermRuler == planetName
+	dignity.Face = dignity.FaceRuler == planetName
+
+	opposite := GetOppositeSign(sign)
+	dignity.Detriment = containsString(GetDomicileRulers(opposite, settings.Rulership), planetName)
+	dignity.Fall = GetExaltationRuler(opposite) == planetName
+	dignity.Peregrine = !dignity.Domicile && !dignity.Exaltation && !dignity.Triplicity && !dignity.Term && !dignity.Face
+
+	if dignity.Domicile {
+		dignity.Score += DomicilePoints
+	}
+	if dignity.Exaltation {
+		dignity.Score += ExaltationPoints
+	}
+	if dignity.Triplicity {
+		dignity.Score += TriplicityPoints
+	}
+	if dignity.Term {
+		dignity.Score += TermPoints
+	}
+	if dignity.Face {
+		dignity.Score += FacePoints
+	}
+	if dignity.Detriment {
+		dignity.Score += DetrimentPoints
+	}
+	if dignity.Fall {
+		dignity.Score += FallPoints
+	}
+	if dignity.Peregrine {
+		dignity.Score += PeregrinePoints
+	}
+
+	return dignity
+}
+
+// GetAlmutenScores returns the essential dignity points each traditional planet holds over a longitude
+func GetAlmutenScores(longitude float64, isNight bool, settings DignitySettings) map[string]int {
+	sign := GetZodiacSign(longitude)
+	scores := make(map[string]int)
+
+	for _, ruler := range GetDomicileRulers(sign, RulershipTraditional) {
+		scores[ruler] += DomicilePoints
+	}
+	if ruler := GetExaltationRuler(sign); ruler != "" {
+		scores[ruler] += ExaltationPoints
+	}
+	for _, ruler := range GetTriplicityRulers(sign, settings.Triplicities, isNight) {
+		scores[ruler] += TriplicityPoints
+	}
+	scores[GetTermRuler(longitude, settings.Terms)] += TermPoints
+	scores[GetFaceRuler(longitude)] += FacePoints
+
+	return scores
+}
+
+// GetAlmuten returns the planet with the most essential dignity over the given longitudes.
+// Ties go to the planet listed first in Chaldean order from Saturn.
+func GetAlmuten(longitudes []float64, isNight bool, settings DignitySettings) (string, map[string]int) {
+	totals := make(map[string]int)
+	for _, longitude := range longitudes {
+		for planet, score := range GetAlmutenScores(longitude, isNight, settings) {
+			totals[planet] += score
+		}
+	}
+
+	almuten := ""
+	for _, planet := range []string{
+		string(Saturn), string(Jupiter), string(Mars), string(Sun),
+		string(Venus), string(Mercury), string(Moon),
+	} {
+		if almuten == "" || totals[planet] > totals[almuten] {
+			almuten = planet
+		}
+	}
+	return almuten, totals
+}
+
+// getEgyptianTerms returns the Egyptian terms as given by Ptolemy in the Tetrabiblos
+func getEgyptianTerms() map[string][]term {
+	return map[string][]term{
+		"Aries":       {{"Jupiter", 6}, {"Venus", 12}, {"Mercury", 20}, {"Mars", 25}, {"Saturn", 30}},
+		"Taurus":      {{"Venus", 8}, {"Mercury", 14}, {"Jupiter", 22}, {"Saturn", 27}, {"Mars", 30}},
+		"Gemini":      {{"Mercury", 6}, {"Jupiter", 12}, {"Venus", 17}, {"Mars", 24}, {"Saturn", 30}},
+		"Cancer":      {{"Mars", 7}, {"Venus", 13}, {"Mercury", 19}, {"Jupiter", 26}, {"Saturn", 30}},
+		"Leo":         {{"Jupiter", 6}, {"Venus", 11}, {"Saturn", 18}, {"Mercury", 24}, {"Mars", 30}},
+		"Virgo":       {{"Mercury", 7}, {"Venus", 17}, {"Jupiter", 21}, {"Mars", 28}, {"Saturn", 30}},
+		"Libra":       {{"Saturn", 6}, {"Mercury", 14}, {"Jupiter", 21}, {"Venus", 28}, {"Mars", 30}},
+		"Scorpio":     {{"Mars", 7}, {"Venus", 11}, {"Mercury", 19}, {"Jupiter", 24}, {"Saturn", 30}},
+		"Sagittarius": {{"Jupiter", 12}, {"Venus", 17}, {"Mercury", 21}, {"Saturn", 26}, {"Mars", 30}},
+		"Capricorn":   {{"Mercury", 7}, {"Jupiter", 14}, {"Venus", 22}, {"Saturn", 26}, {"Mars", 30}},
+		"Aquarius":    {{"Mercury", 7}, {"Venus", 13}, {"Jupiter", 20}, {"Mars", 25}, {"Saturn", 30}},
+		"Pisces":      {{"Venus", 12}, {"Jupiter", 16}, {"Mercury", 19}, {"Mars", 28}, {"Saturn", 30}},
+	}
+}
+
+// getPtolemaicTerms returns Ptolemy's own terms as tabulated by Lilly
+func getPtolemaicTerms() map[string][]term {
+	return map[string][]term{
+		"Aries":       {{"Jupiter", 6}, {"Venus", 14}, {"Mercury", 21}, {"Mars", 26}, {"Saturn", 30}},
+		"Taurus":      {{"Venus", 8}, {"Mercury", 15}, {"Jupiter", 22}, {"Saturn", 26}, {"Mars", 30}},
+		"Gemini":      {{"Mercury", 7}, {"Jupiter", 14}, {"Venus", 21}, {"Saturn", 25}, {"Mars", 30}},
+		"Cancer":      {{"Mars", 6}, {"Jupiter", 13}, {"Mercury", 20}, {"Venus", 27}, {"Saturn", 30}},
+		"Leo":         {{"Saturn", 6}, {"Mercury", 13}, {"Venus", 19}, {"Jupiter", 25}, {"Mars", 30}},
+		"Virgo":       {{"Mercury", 7}, {"Venus", 13}, {"Jupiter", 18}, {"Saturn", 24}, {"Mars", 30}},
+		"Libra":       {{"Saturn", 6}, {"Venus", 11}, {"Jupiter", 19}, {"Mercury", 24}, {"Mars", 30}},
+		"Scorpio":     {{"Mars", 6}, {"Jupiter", 14}, {"Venus", 21}, {"Mercury", 27}, {"Saturn", 30}},
+		"Sagittarius": {{"Jupiter", 8}, {"Venus", 14}, {"Mercury", 19}, {"Saturn", 25}, {"Mars", 30}},
+		"Capricorn":   {{"Venus", 6}, {"Mercury", 12}, {"Jupiter", 19}, {"Mars", 25}, {"Saturn", 30}},
+		"Aquarius":    {{"Saturn", 6}, {"Mercury", 12}, {"Venus", 20}, {"Jupiter", 25}, {"Mars", 30}},
+		"Pisces":      {{"Venus", 8}, {"Jupiter", 14}, {"Mercury", 20}, {"Mars", 26}, {"Saturn", 30}},
+	}
+}
+
+// containsString reports whether value is in values
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDignitySettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings DignitySettings
+		want     DignitySettings
+		wantErr  bool
+	}{
+		{"empty uses defaults", DignitySettings{}, DefaultDignitySettings(), false},
+		{"keeps explicit tables", DignitySettings{Rulership: RulershipModern, Triplicities: TriplicityLilly, Terms: TermsPtolemaic},
+			DignitySettings{Rulership: RulershipModern, Triplicities: TriplicityLilly, Terms: TermsPtolemaic}, false},
+		{"unknown rulership", DignitySettings{Rulership: "hellenistic"}, DignitySettings{}, true},
+		{"unknown triplicities", DignitySettings{Triplicities: "ptolemy"}, DignitySettings{}, true},
+		{"unknown terms", DignitySettings{Terms: "chaldean"}, DignitySettings{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := tt.settings
+			err := settings.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && settings != tt.want {
+				t.Errorf("Validate() settings = %+v, want %+v", settings, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSignRuler(t *testing.T) {
+	tests := []struct {
+		sign      string
+		rulership string
+		want      string
+	}{
+		{"Aries", RulershipTraditional, "Mars"},
+		{"Scorpio", RulershipTraditional, "Mars"},
+		{"Scorpio", RulershipModern, "Pluto"},
+		{"Aquarius", RulershipTraditional, "Saturn"},
+		{"Aquarius", RulershipModern, "Uranus"},
+		{"Pisces", RulershipModern, "Neptune"},
+		{"Leo", RulershipModern, "Sun"},
+	}
+
+	for _, tt := range tests {
+		if got := GetSignRuler(tt.sign, tt.rulership); got != tt.want {
+			t.Errorf("GetSignRuler(%q, %q) = %q, want %q", tt.sign, tt.rulership, got, tt.want)
+		}
+	}
+}
+
+func TestGetDomicileRulers(t *testing.T) {
+	tests := []struct {
+		sign      string
+		rulership string
+		want      []string
+	}{
+		{"Aquarius", RulershipTraditional, []string{"Saturn"}},
+		{"Aquarius", RulershipModern, []string{"Saturn", "Uranus"}},
+		{"Taurus", RulershipModern, []string{"Venus"}},
+	}
+
+	for _, tt := range tests {
+		if got := GetDomicileRulers(tt.sign, tt.rulership); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetDomicileRulers(%q, %q) = %v, want %v", tt.sign, tt.rulership, got, tt.want)
+		}
+	}
+}
+
+func TestGetExaltationRuler(t *testing.T) {
+	tests := []struct {
+		sign string
+		want string
+	}{
+		{"Aries", "Sun"},
+		{"Libra", "Saturn"},
+		{"Pisces", "Venus"},
+		{"Gemini", ""},
+	}
+
+	for _, tt := range tests {
+		if got := GetExaltationRuler(tt.sign); got != tt.want {
+			t.Errorf("GetExaltationRuler(%q) = %q, want %q", tt.sign, got, tt.want)
+		}
+	}
+}
+
+func TestGetTriplicityRulers(t *testing.T) {
+	tests := []struct {
+		sign    string
+		system  string
+		isNight bool
+		want    []string
+	}{
+		{"Leo", TriplicityDorothean, false, []string{"Sun", "Jupiter", "Saturn"}},
+		{"Leo", TriplicityDorothean, true, []string{"Jupiter", "Sun", "Saturn"}},
+		{"Cancer", TriplicityDorothean, true, []string{"Mars", "Venus", "Moon"}},
+		{"Cancer", TriplicityLilly, false, []string{"Mars"}},
+		{"Taurus", TriplicityLilly, true, []string{"Moon"}},
+	}
+
+	for _, tt := range tests {
+		if got := GetTriplicityRulers(tt.sign, tt.system, tt.isNight); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetTriplicityRulers(%q, %q, %v) = %v, want %v", tt.sign, tt.system, tt.isNight, got, tt.want)
+		}
+	}
+}
+
+func TestGetTermRuler(t *testing.T) {
+	tests := []struct {
+		longitude float64
+		system    string
+		want      string
+	}{
+		{0, TermsEgyptian, "Jupiter"},
+		{6, TermsEgyptian, "Venus"}, // term boundaries are exclusive
+		{13, TermsEgyptian, "Mercury"},
+		{13, TermsPtolemaic, "Venus"},
+		{29.9, TermsEgyptian, "Saturn"},
+		{125, TermsEgyptian, "Jupiter"},
+		{125, TermsPtolemaic, "Saturn"},
+	}
+
+	for _, tt := range tests {
+		if got := GetTermRuler(tt.longitude, tt.system); got != tt.want {
+			t.Errorf("GetTermRuler(%v, %q) = %q, want %q", tt.longitude, tt.system, got, tt.want)
+		}
+	}
+}
+
+func TestGetFaceRuler(t *testing.T) {
+	tests := []struct {
+		longitude float64
+		want      string
+	}{
+		{0, "Mars"},
+		{10, "Sun"},
+		{25, "Venus"},
+		{355, "Mars"},
+		{-5, "Mars"},
+	}
+
+	for _, tt := range tests {
+		if got := GetFaceRuler(tt.longitude); got != tt.want {
+			t.Errorf("GetFaceRuler(%v) = %q, want %q", tt.longitude, got, tt.want)
+		}
+	}
+}
+
+func TestNewEssentialDignity(t *testing.T) {
+	traditional := DefaultDignitySettings()
+	modern := DignitySettings{Rulership: RulershipModern, Triplicities: TriplicityDorothean, Terms: TermsEgyptian}
+
+	tests := []struct {
+		name      string
+		planet    string
+		longitude float64
+		isNight   bool
+		settings  DignitySettings
+		check     func(EssentialDignity) bool
+		wantScore int
+	}{
+		{"Sun exalted in Aries by day", "Sun", 10, false, traditional,
+			func(d EssentialDignity) bool { return d.Exaltation && d.Triplicity && d.Face && !d.Peregrine }, 8},
+		{"Mars at home in Aries", "Mars", 5, false, traditional,
+			func(d EssentialDignity) bool { return d.Domicile && d.Face && !d.Term }, 6},
+		{"Venus in detriment and peregrine in Aries", "Venus", 15, false, traditional,
+			func(d EssentialDignity) bool { return d.Detriment && d.Peregrine }, -10},
+		{"Saturn in fall with triplicity in Aries", "Saturn", 5, false, traditional,
+			func(d EssentialDignity) bool { return d.Fall && d.Triplicity && !d.Peregrine }, -1},
+		{"Uranus at home in Aquarius with modern rulers", "Uranus", 300, false, modern,
+			func(d EssentialDignity) bool { return d.Domicile && d.DomicileRuler == "Uranus" }, 5},
+		{"Uranus peregrine in Aquarius with traditional rulers", "Uranus", 300, false, traditional,
+			func(d EssentialDignity) bool { return !d.Domicile && d.Peregrine }, -5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewEssentialDignity(tt.planet, tt.longitude, tt.isNight, tt.settings)
+			if !tt.check(got) {
+				t.Errorf("NewEssentialDignity() = %+v", got)
+			}
+			if got.Score != tt.wantScore {
+				t.Errorf("NewEssentialDignity() score = %d, want %d", got.Score, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGetAlmuten(t *testing.T) {
+	tests := []struct {
+		name       string
+		longitudes []float64
+		isNight    bool
+		want       string
+		wantScore  int
+	}{
+		// 10° Aries by day: Sun exaltation, triplicity and face
+		{"single degree", []float64{10}, false, "Sun", 8},
+		// 20° Libra: Saturn exaltation and triplicity outweigh Jupiter's triplicity, term and face
+		{"exaltation and triplicity", []float64{200}, true, "Saturn", 7},
+		// Saturn also shares the fire triplicity, so it overtakes the Sun over both places
+		{"summed over places", []float64{10, 200}, false, "Saturn", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, totals := GetAlmuten(tt.longitudes, tt.isNight, DefaultDignitySettings())
+			if got != tt.want || totals[got] != tt.wantScore {
+				t.Errorf("GetAlmuten() = %q (%d), want %q (%d); totals %v", got, totals[got], tt.want, tt.wantScore, totals)
+			}
+		})
+	}
+}
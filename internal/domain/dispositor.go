@@ -0,0 +1,137 @@
+package domain
+
+import "sort"
+
+// ReceptionType represents the kind of mutual reception between two planets
+type ReceptionType string
+
+const (
+	ReceptionDomicile   ReceptionType = "domicile"   // Each planet in the other's domicile
+	ReceptionExaltation ReceptionType = "exaltation" // Each planet in the other's exaltation
+	ReceptionMixed      ReceptionType = "mixed"      // One by domicile, the other by exaltation
+)
+
+// MutualReception represents two planets receiving each other
+type MutualReception struct {
+	Planet1 string        `json:"planet1"`
+	Planet2 string        `json:"planet2"`
+	Type    ReceptionType `json:"type"`
+}
+
+// DispositorChain is the sequence of dispositors followed from a planet until
+// a planet repeats or a dispositor is not in the chart
+type DispositorChain struct {
+	Planet string   `json:"planet"`
+	Chain  []string `json:"chain"` // Starts with the planet itself
+}
+
+// DispositorReport holds the dispositorship graph of a chart
+type DispositorReport struct {
+	Rulership        string            `json:"rulership"`
+	Dispositors      map[string]string `json:"dispositors"` // Planet -> ruler of the sign it occupies
+	Chains           []DispositorChain `json:"chains"`
+	Loops            [][]string        `json:"loops"`                      // Cycles; a single planet is in its own domicile
+	FinalDispositor  string            `json:"final_dispositor,omitempty"` // Only when every chain ends in one planet in its own domicile
+	MutualReceptions []MutualReception `json:"mutual_receptions"`
+	ChartDraw        string            `json:"chart_draw,omitempty"` // SVG dispositor tree
+}
+
+// NewDispositorReport builds the dispositorship graph of the planets that hold
+// essential dignities under the rulership scheme
+func NewDispositorReport(planets []Planet, rulership string) DispositorReport {
+	report := DispositorReport{
+		Rulership:   rulership,
+		Dispositors: make(map[string]string),
+	}
+
+	var names []string
+	signs := make(map[string]string)
+	for _, planet := range planets {
+		if !HasEssentialDignities(planet.Name, rulership) {
+			continue
+		}
+		names = append(names, planet.Name)
+		signs[planet.Name] = planet.Sign
+		report.Dispositors[planet.Name] = GetSignRuler(planet.Sign, rulership)
+	}
+
+	// Chains and the loops they end in
+	loopOf := make(map[string]int)
+	for _, name := range names {
+		chain := []string{name}
+		position := map[string]int{name: 0}
+		current := name
+		for {
+			next := report.Dispositors[current]
+			if _, inChart := signs[next]; !inChart {
+				break
+			}
+			if start, seen := position[next]; seen {
+				loop := chain[start:]
+				if _, known := loopOf[loop[0]]; !known {
+					for _, member := range loop {
+						loopOf[member] = len(report.Loops)
+					}
+					report.Loops = append(report.Loops, append([]string(nil), loop...))
+				}
+				break
+			}
+			position[next] = len(chain)
+			chain = append(chain, next)
+			current = next
+		}
+		report.Chains = append(report.Chains, DispositorChain{Planet: name, Chain: chain})
+	}
+
+	// A final dispositor rules the whole chart: a single loop of one planet reached by every chain
+	if len(report.Loops) == 1 && len(report.Loops[0]) == 1 {
+		final := report.Loops[0][0]
+		reachesFinal := true
+		for _, chain := range report.Chains {
+			if chain.Chain[len(chain.Chain)-1] != final {
+				reachesFinal = false
+			}
+		}
+		if reachesFinal {
+			report.FinalDispositor = final
+		}
+	}
+
+	// Mutual receptions
+	sort.Strings(names)
+	for i := 0; i < len(names); i++ {
+		for j := i + 1; j < len(names); j++ {
+			if reception, exists := getMutualReception(names[i], signs[names[i]], names[j], signs[names[j]], rulership); exists {
+				report.MutualReceptions = append(report.MutualReceptions, MutualReception{
+					Planet1: names[i],
+					Planet2: names[j],
+					Type:    reception,
+				})
+			}
+		}
+	}
+
+	return report
+}
+
+// getMutualReception returns the reception between two planets in the given signs, if any
+func getMutualReception(planet1, sign1, planet2, sign2, rulership string) (ReceptionType, bool) {
+	// A planet in sign is received by the lord of that sign
+	inDomicileOf := func(sign, planet string) bool {
+		return containsString(GetDomicileRulers(sign, rulership), planet)
+	}
+	inExaltationOf := func(sign, planet string) bool {
+		return GetExaltationRuler(sign) == planet
+	}
+
+	switch {
+	case inDomicileOf(sign1, planet2) && inDomicileOf(sign2, planet1):
+		return ReceptionDomicile, true
+	case inExaltationOf(sign1, planet2) && inExaltationOf(sign2, planet1):
+		return ReceptionExaltation, true
+	case inDomicileOf(sign1, planet2) && inExaltationOf(sign2, planet1),
+		inExaltationOf(sign1, planet2) && inDomicileOf(sign2, planet1):
+		return ReceptionMixed, true
+	}
+	return "", false
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Events in the diurnal motion of a star or planet
+const (
+	EventRising          = "rising"
+	EventSetting         = "setting"
+	EventCulminating     = "culminating"      // Upper meridian (MC)
+	EventAntiCulminating = "anti-culminating" // Lower meridian (IC)
+)
+
+// FixedStar represents the ecliptic position of a fixed star
+type FixedStar struct {
+	Name        string  `json:"name"`
+	Designation string  `json:"designation"` // Bayer/Flamsteed designation, e.g. "alTau"
+	Longitude   float64 `json:"longitude"`
+	Latitude    float64 `json:"latitude"`
+	Sign        string  `json:"sign"`
+	Degree      string  `json:"degree"`
+	Magnitude   float64 `json:"magnitude"`
+}
+
+// StarConjunction represents a fixed star conjunct a planet or chart angle
+type StarConjunction struct {
+	Star  string  `json:"star"`
+	Point string  `json:"point"` // Planet or angle name
+	Orb   float64 `json:"orb"`   // Ecliptic distance in degrees
+}
+
+// StarParan represents a star and a planet on the angles at the same moment of the birth day
+type StarParan struct {
+	Star      string    `json:"star"`
+	StarEvent string    `json:"star_event"`
+	Planet    string    `json:"planet"`
+	Event     string    `json:"planet_event"`
+	Time      time.Time `json:"time"`    // Local time of the star event
+	Minutes   float64   `json:"minutes"` // Time difference between the two events
+}
+
+// AngularStar represents a star rising, setting or on the meridian at the birth moment
+type AngularStar struct {
+	Star    string    `json:"star"`
+	Event   string    `json:"event"`
+	Time    time.Time `json:"time"`    // Local time of the event
+	Minutes float64   `json:"minutes"` // Time difference from the birth moment
+}
+
+// Fixed star presets
+const (
+	StarPresetBehenian = "behenian"
+	StarPresetRoyal    = "royal"
+)
+
+// behenianStars lists the fifteen Behenian stars of medieval astrology
+var behenianStars = []string{
+	"Algol", "Alcyone", "Aldebaran", "Capella", "Sirius", "Procyon", "Regulus", "Alkaid",
+	"Algorab", "Spica", "Arcturus", "Alphecca", "Antares", "Vega", "Deneb Algedi",
+}
+
+// royalStars lists the four Royal stars (watchers of the heavens)
+var royalStars = []string{"Aldebaran", "Regulus", "Antares", "Fomalhaut"}
+
+// NewFixedStar creates a fixed star from its catalog name ("Name,designation") and position
+func NewFixedStar(catalogName string, longitude, latitude, magnitude float64) FixedStar {
+	name, designation := catalogName, ""
+	if parts := strings.SplitN(catalogName, ",", 2); len(parts) == 2 {
+		name, designation = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	}
+
+	return FixedStar{
+		Name:        name,
+		Designation: designation,
+		Longitude:   longitude,
+		Latitude:    latitude,
+		Sign:        GetZodiacSign(longitude),
+		Degree:      FormatDegreeInSign(longitude),
+		Magnitude:   magnitude,
+	}
+}
+
+// GetStarPreset returns the star names of a preset, or nil if the preset is unknown
+func GetStarPreset(preset string) []string {
+	switch strings.ToLower(preset) {
+	case StarPresetBehenian:
+		return append([]string(nil), behenianStars...)
+	case StarPresetRoyal:
+		return append([]string(nil), royalStars...)
+	default:
+		return nil
+	}
+}
+
+// GetAvailableStarPresets returns the supported fixed star presets
+func GetAvailableStarPresets() []string {
+	return []string{StarPresetBehenian, StarPresetRoyal}
+}
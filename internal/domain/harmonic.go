@@ -0,0 +1,19 @@
+package domain
+
+// HarmonicStrength represents how strongly a harmonic is expressed in a chart
+type HarmonicStrength struct {
+	Harmonic     int     `json:"harmonic"`
+	Amplitude    float64 `json:"amplitude"`    // Resultant length of the harmonic positions (0-1)
+	Conjunctions int     `json:"conjunctions"` // Conjunctions formed in the harmonic chart
+}
+
+// HarmonicSpectrum summarizes the harmonics of a chart
+type HarmonicSpectrum struct {
+	Harmonics []HarmonicStrength `json:"harmonics"`
+	Strongest []int              `json:"strongest"` // Harmonics ordered by amplitude, strongest first
+}
+
+// HarmonicLongitude multiplies a longitude by a harmonic number and normalizes it
+func HarmonicLongitude(longitude, harmonic float64) float64 {
+	return normalizeAngle(longitude * harmonic)
+}
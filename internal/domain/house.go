@@ -11,18 +11,31 @@ const (
 	HouseCampanus      HouseSystem = "Campanus"
 	HouseEqual         HouseSystem = "Equal"
 	HouseWholeSign     HouseSystem = "Whole Sign"
+	HouseAlcabitius    HouseSystem = "Alcabitius"
+	HouseMorinus       HouseSystem = "Morinus"
+	HouseTopocentric   HouseSystem = "Topocentric"
+	HouseMeridian      HouseSystem = "Meridian" // Axial rotation
+	HouseVehlow        HouseSystem = "Vehlow"   // Equal houses with the Ascendant in the middle of the 1st
+	HouseSripati       HouseSystem = "Sripati"
+	HouseKrusinski     HouseSystem = "Krusinski"
+	HouseAPC           HouseSystem = "APC"
+	HouseHorizontal    HouseSystem = "Horizontal"
+	HousePolichPage    HouseSystem = "Polich-Page" // Same cusps as Topocentric
+	HouseSunshine      HouseSystem = "Sunshine"
 )
 
 // House represents an astrological house
 type House struct {
 	Number    int     `json:"house"`
-	Cusp      string  `json:"cusp"`       // Formatted degree string
-	Sign      string  `json:"sign"`       // Zodiac sign on cusp
-	CuspValue float64 `json:"cusp_value"` // Raw cusp degree value
-	Size      float64 `json:"size"`       // House size in degrees
-	Element   string  `json:"element"`    // Element of sign on cusp
-	Modality  string  `json:"modality"`   // Modality of sign on cusp
-	Ruler     string  `json:"ruler"`      // Traditional ruler of sign on cusp
+	Cusp      string  `json:"cusp"`              // Formatted degree string
+	Sign      string  `json:"sign"`              // Zodiac sign on cusp
+	CuspValue float64 `json:"cusp_value"`        // Raw cusp degree value
+	Size      float64 `json:"size"`              // House size in degrees
+	CuspSpeed float64 `json:"cusp_speed"`        // Daily motion of the cusp in degrees
+	Element   string  `json:"element"`           // Element of sign on cusp
+	Modality  string  `json:"modality"`          // Modality of sign on cusp
+	Ruler     string  `json:"ruler"`             // Ruler of sign on cusp (traditional unless modern rulership is requested)
+	Almuten   string  `json:"almuten,omitempty"` // Planet with most essential dignity over the cusp
 }
 
 // HouseInfo contains metadata about houses
@@ -0,0 +1,143 @@
+package domain
+
+// KPLords holds the Krishnamurti Paddhati lords of a sidereal longitude
+type KPLords struct {
+	SignLord   string `json:"sign_lord"`
+	StarLord   string `json:"star_lord"`
+	SubLord    string `json:"sub_lord"`
+	SubSubLord string `json:"sub_sub_lord"`
+}
+
+// KPSubdivision represents one of the 249 KP sub divisions of the zodiac
+type KPSubdivision struct {
+	Number   int     `json:"number"` // 1-249
+	Sign     string  `json:"sign"`
+	Start    float64 `json:"start"` // Sidereal longitude where the sub begins
+	End      float64 `json:"end"`   // Sidereal longitude where the sub ends
+	SignLord string  `json:"sign_lord"`
+	Star     string  `json:"star"` // Nakshatra name
+	StarLord string  `json:"star_lord"`
+	SubLord  string  `json:"sub_lord"`
+}
+
+// KPCusp represents a sidereal Placidus cusp with its KP lords
+type KPCusp struct {
+	House     int     `json:"house"`
+	Longitude float64 `json:"longitude"`
+	Sign      string  `json:"sign"`
+	Degree    string  `json:"degree"`
+	Nakshatra string  `json:"nakshatra"`
+	KPLords
+}
+
+// KPPlanet represents a sidereal planet with its Placidus house and KP lords
+type KPPlanet struct {
+	Name         string  `json:"name"`
+	Longitude    float64 `json:"longitude"`
+	Sign         string  `json:"sign"`
+	Degree       string  `json:"degree"`
+	House        int     `json:"house"`
+	IsRetrograde bool    `json:"is_retrograde"`
+	Nakshatra    string  `json:"nakshatra"`
+	KPLords
+}
+
+// KPSignificators lists the planets signifying a house, from strongest (level 1) to weakest (level 4)
+type KPSignificators struct {
+	House  int      `json:"house"`
+	Level1 []string `json:"level_1"` // Planets in the star of occupants of the house
+	Level2 []string `json:"level_2"` // Occupants of the house
+	Level3 []string `json:"level_3"` // Planets in the star of the owner of the house
+	Level4 []string `json:"level_4"` // Owner of the house (sign lord of the cusp)
+}
+
+// KPChart holds a complete Krishnamurti Paddhati analysis
+type KPChart struct {
+	Ayanamsa      string            `json:"ayanamsa"`
+	AyanamsaValue float64           `json:"ayanamsa_value"`
+	Cusps         []KPCusp          `json:"cusps"`
+	Planets       []KPPlanet        `json:"planets"`
+	Significators []KPSignificators `json:"significators"`
+	Warnings      []string          `json:"warnings,omitempty"` // Fallbacks applied during the calculation
+}
+
+// vimshottariTotalYears is the length of the full Vimshottari cycle
+const vimshottariTotalYears = 120.0
+
+// GetKPLords returns the sign, star, sub and sub-sub lords of a sidereal longitude
+func GetKPLords(siderealLongitude float64) KPLords {
+	longitude := normalizeAngle(siderealLongitude)
+	nakshatra := GetNakshatraIndex(longitude)
+	starLord := GetNakshatraLord(nakshatra)
+
+	// Each nakshatra is divided among the nine lords in proportion to their dasha years,
+	// starting from the star lord; each sub is divided again the same way
+	offset := longitude - float64(nakshatra)*NakshatraSpan
+	subLord, subOffset, subSpan := vimshottariDivision(starLord, offset, NakshatraSpan)
+	subSubLord, _, _ := vimshottariDivision(subLord, subOffset, subSpan)
+
+	return KPLords{
+		SignLord:   GetRulerForSign(GetZodiacSign(longitude)),
+		StarLord:   starLord,
+		SubLord:    subLord,
+		SubSubLord: subSubLord,
+	}
+}
+
+// GetKPSubdivisions returns the 249 sub divisions of the zodiac, split at sign boundaries
+func GetKPSubdivisions() []KPSubdivision {
+	var subdivisions []KPSubdivision
+
+	for nakshatra := 0; nakshatra < 27; nakshatra++ {
+		starLord := GetNakshatraLord(nakshatra)
+		start := float64(nakshatra) * NakshatraSpan
+
+		for _, subLord := range GetVimshottariSequence(starLord) {
+			end := start + NakshatraSpan*GetVimshottariYears(subLord)/vimshottariTotalYears
+
+			// A sub crossing a sign boundary is counted once in each sign
+			boundary := float64(int(start/30)+1) * 30
+			segments := [][2]float64{{start, end}}
+			if end-boundary > 1e-9 && boundary-start > 1e-9 {
+				segments = [][2]float64{{start, boundary}, {boundary, end}}
+			}
+
+			for _, segment := range segments {
+				sign := GetZodiacSign(segment[0])
+				subdivisions = append(subdivisions, KPSubdivision{
+					Number:   len(subdivisions) + 1,
+					Sign:     sign,
+					Start:    segment[0],
+					End:      segment[1],
+					SignLord: GetRulerForSign(sign),
+					Star:     GetNakshatraName(nakshatra),
+					StarLord: starLord,
+					SubLord:  subLord,
+				})
+			}
+
+			start = end
+		}
+	}
+
+	return subdivisions
+}
+
+// vimshottariDivision finds which Vimshottari lord owns an offset within a span divided
+// proportionally from the starting lord; it returns the lord, the offset within its part and the part's span
+func vimshottariDivision(startLord string, offset, span float64) (string, float64, float64) {
+	sequence := GetVimshottariSequence(startLord)
+
+	for _, lord := range sequence {
+		part := span * GetVimshottariYears(lord) / vimshottariTotalYears
+		if offset < part {
+			return lord, offset, part
+		}
+		offset -= part
+	}
+
+	// Rounding at the very end of the span belongs to the last lord
+	last := sequence[len(sequence)-1]
+	part := span * GetVimshottariYears(last) / vimshottariTotalYears
+	return last, part, part
+}
@@ -184,8 +184,11 @@ func (l Location) IsWithinDistance(other Location, maxDistance float64) bool {
 
 // GetCardinalDirection returns the cardinal direction to another location
 func (l Location) GetCardinalDirection(other Location) string {
-	bearing := l.BearingTo(other)
+	return GetCompassDirection(l.BearingTo(other))
+}
 
+// GetCompassDirection returns the 16-point compass direction of a bearing in degrees
+func GetCompassDirection(bearing float64) string {
 	directions := []string{
 		"N", "NNE", "NE", "ENE",
 		"E", "ESE", "SE", "SSE",
@@ -193,7 +196,7 @@ func (l Location) GetCardinalDirection(other Location) string {
 		"W", "WNW", "NW", "NNW",
 	}
 
-	index := int((bearing+11.25)/22.5) % 16
+	index := int((normalizeAngle(bearing)+11.25)/22.5) % 16
 	return directions[index]
 }
 
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TypeLot classifies Arabic parts / Hellenistic lots used as chart points
+const TypeLot PlanetType = "lot"
+
+// lotPrefix is prepended to lot names, e.g. "Lot of Fortune"
+const lotPrefix = "Lot of "
+
+// Standard Hellenistic lots
+const (
+	LotFortune   = "Fortune"
+	LotSpirit    = "Spirit"
+	LotEros      = "Eros"
+	LotNecessity = "Necessity"
+	LotCourage   = "Courage"
+	LotVictory   = "Victory"
+	LotNemesis   = "Nemesis"
+)
+
+// LotDefinition describes a lot by its day formula, e.g. "Asc + Moon - Sun"
+type LotDefinition struct {
+	Name    string `json:"name" binding:"required"`
+	Formula string `json:"formula" binding:"required"`
+}
+
+// LotTerm is a single signed point in a lot formula
+type LotTerm struct {
+	Point string `json:"point"`
+	Sign  int    `json:"sign"` // +1 or -1
+}
+
+// Lot represents a calculated Arabic part / Hellenistic lot
+type Lot struct {
+	Name       string  `json:"name"`    // e.g. "Lot of Fortune"
+	Formula    string  `json:"formula"` // Formula actually applied (reversed for night charts)
+	Longitude  float64 `json:"longitude"`
+	Speed      float64 `json:"speed"` // Daily motion in degrees
+	Sign       string  `json:"sign"`
+	Degree     string  `json:"degree"`
+	House      int     `json:"house"`
+	IsReversed bool    `json:"is_reversed"` // Whether the night formula was used
+}
+
+// GetStandardLots returns the day formulas of the seven Hermetic lots (after Paulus Alexandrinus)
+func GetStandardLots() []LotDefinition {
+	return []LotDefinition{
+		{Name: LotFortune, Formula: "Asc + Moon - Sun"},
+		{Name: LotSpirit, Formula: "Asc + Sun - Moon"},
+		{Name: LotEros, Formula: "Asc + Venus - Spirit"},
+		{Name: LotNecessity, Formula: "Asc + Fortune - Mercury"},
+		{Name: LotCourage, Formula: "Asc + Fortune - Mars"},
+		{Name: LotVictory, Formula: "Asc + Jupiter - Spirit"},
+		{Name: LotNemesis, Formula: "Asc + Fortune - Saturn"},
+	}
+}
+
+// GetLotName returns the display name of a lot ("Fortune" becomes "Lot of Fortune")
+func GetLotName(name string) string {
+	name = strings.TrimSpace(name)
+	if IsLotName(name) {
+		return name
+	}
+	return lotPrefix + name
+}
+
+// IsLotName returns true if the name refers to a lot
+func IsLotName(name string) bool {
+	return strings.HasPrefix(name, lotPrefix)
+}
+
+// ParseLotFormula parses a formula such as "Asc + Venus - Sun" into signed terms
+func ParseLotFormula(formula string) ([]LotTerm, error) {
+	var terms []LotTerm
+	sign := 1
+	var point strings.Builder
+
+	flush := func() error {
+		name := strings.TrimSpace(point.String())
+		point.Reset()
+		if name == "" {
+			return fmt.Errorf("invalid lot formula: %s", formula)
+		}
+		terms = append(terms, LotTerm{Point: name, Sign: sign})
+		return nil
+	}
+
+	for _, r := range formula {
+		switch r {
+		case '+', '-':
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			sign = 1
+			if r == '-' {
+				sign = -1
+			}
+		default:
+			point.WriteRune(r)
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if len(terms) < 2 {
+		return nil, fmt.Errorf("lot formula needs at least two points: %s", formula)
+	}
+
+	return terms, nil
+}
+
+// ReverseLotTerms swaps the added and subtracted points after the first one (night formula)
+func ReverseLotTerms(terms []LotTerm) []LotTerm {
+	reversed := make([]LotTerm, len(terms))
+	for i, term := range terms {
+		reversed[i] = term
+		if i > 0 {
+			reversed[i].Sign = -term.Sign
+		}
+	}
+	return reversed
+}
+
+// FormatLotFormula formats signed terms back into a formula string
+func FormatLotFormula(terms []LotTerm) string {
+	var formula strings.Builder
+	for i, term := range terms {
+		switch {
+		case i == 0 && term.Sign < 0:
+			formula.WriteString("-")
+		case i > 0 && term.Sign < 0:
+			formula.WriteString(" - ")
+		case i > 0:
+			formula.WriteString(" + ")
+		}
+		formula.WriteString(term.Point)
+	}
+	return formula.String()
+}
+
+// ToPlanet converts a lot into a chart point so it can take part in aspects and drawings
+func (l Lot) ToPlanet() Planet {
+	return NewPlanet(l.Name, l.Longitude, 0, l.Speed, l.House)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import "math"
+
+// Dial sizes used in Uranian astrology / Cosmobiology
+const (
+	Dial90   = 90.0  // Hard aspects: conjunction, square, opposition
+	Dial45   = 45.0  // Adds semi-squares and sesquiquadrates
+	Dial22_5 = 22.5  // Adds 22.5° multiples
+	Dial360  = 360.0 // Plain zodiac (conjunctions only)
+)
+
+// Midpoint represents the (nearer) midpoint between two chart points
+type Midpoint struct {
+	Name         string  `json:"name"` // e.g. "Sun/Moon"
+	Point1       string  `json:"point1"`
+	Point2       string  `json:"point2"`
+	Longitude    float64 `json:"longitude"`
+	Sign         string  `json:"sign"`
+	Degree       string  `json:"degree"`
+	DialPosition float64 `json:"dial_position"` // Longitude reduced to the dial
+}
+
+// MidpointContact represents a midpoint falling on a point in the dial
+type MidpointContact struct {
+	Midpoint string  `json:"midpoint"`
+	Orb      float64 `json:"orb"`
+}
+
+// MidpointTree lists the midpoints that fall on a chart point (e.g. "Sun = Mars/Jupiter")
+type MidpointTree struct {
+	Point        string            `json:"point"`
+	Longitude    float64           `json:"longitude"`
+	DialPosition float64           `json:"dial_position"`
+	Midpoints    []MidpointContact `json:"midpoints"`
+}
+
+// PlanetaryPicture represents a planetary picture A + B - C = D (equivalently A/B = C/D)
+type PlanetaryPicture struct {
+	Formula        string  `json:"formula"` // e.g. "Sun + Moon - Mars = Venus"
+	PointA         string  `json:"point_a"`
+	PointB         string  `json:"point_b"`
+	PointC         string  `json:"point_c"`
+	PointD         string  `json:"point_d"`         // Point occupying the sensitive point
+	SensitivePoint float64 `json:"sensitive_point"` // Longitude of A + B - C
+	Sign           string  `json:"sign"`
+	Degree         string  `json:"degree"`
+	Orb            float64 `json:"orb"`
+}
+
+// MidpointAnalysis contains all midpoints, midpoint trees and planetary pictures of a chart
+type MidpointAnalysis struct {
+	Dial      float64            `json:"dial"`
+	Orb       float64            `json:"orb"`
+	Midpoints []Midpoint         `json:"midpoints"`
+	Trees     []MidpointTree     `json:"trees"`
+	Pictures  []PlanetaryPicture `json:"pictures"`
+}
+
+// GetAvailableDials returns the supported dial sizes
+func GetAvailableDials() []float64 {
+	return []float64{Dial360, Dial90, Dial45, Dial22_5}
+}
+
+// IsValidDial returns true if the dial size is supported
+func IsValidDial(dial float64) bool {
+	for _, d := range GetAvailableDials() {
+		if d == dial {
+			return true
+		}
+	}
+	return false
+}
+
+// CalculateMidpoint returns the nearer midpoint of two longitudes
+func CalculateMidpoint(lon1, lon2 float64) float64 {
+	diff := normalizeAngle(lon2 - lon1)
+	if diff > 180 {
+		diff -= 360
+	}
+	return normalizeAngle(lon1 + diff/2)
+}
+
+// DialPosition reduces a longitude to a position on the dial
+func DialPosition(longitude, dial float64) float64 {
+	return math.Mod(normalizeAngle(longitude), dial)
+}
+
+// DialDistance returns the shortest distance between two longitudes on the dial
+func DialDistance(lon1, lon2, dial float64) float64 {
+	distance := math.Mod(math.Abs(normalizeAngle(lon1)-normalizeAngle(lon2)), dial)
+	return math.Min(distance, dial-distance)
+}
@@ -0,0 +1,71 @@
+package domain
+
+// ChartShape represents a Marc Edmund Jones planetary pattern
+type ChartShape string
+
+const (
+	ShapeBundle     ChartShape = "bundle"     // All planets within a trine
+	ShapeBowl       ChartShape = "bowl"       // All planets within half the chart
+	ShapeBucket     ChartShape = "bucket"     // A bowl with a single planet as handle on the empty side
+	ShapeLocomotive ChartShape = "locomotive" // All planets within two thirds of the chart
+	ShapeSeesaw     ChartShape = "seesaw"     // Two opposed groups separated by two empty areas
+	ShapeSplay      ChartShape = "splay"      // Three or more irregular groups
+	ShapeSplash     ChartShape = "splash"     // Planets spread evenly around the chart
+)
+
+// HemisphereEmphasis holds the weighted distribution of the planets in hemispheres and quadrants
+type HemisphereEmphasis struct {
+	Eastern   float64    `json:"eastern"`   // Midheaven to IC through the Ascendant (houses 10-3)
+	Western   float64    `json:"western"`   // IC to Midheaven through the Descendant (houses 4-9)
+	Northern  float64    `json:"northern"`  // Below the horizon (houses 1-6)
+	Southern  float64    `json:"southern"`  // Above the horizon (houses 7-12)
+	Quadrants [4]float64 `json:"quadrants"` // Quadrant I (houses 1-3) to IV (houses 10-12)
+	Emphasis  []string   `json:"emphasis"`  // Hemispheres holding clearly more than their opposite
+}
+
+// Balance holds the weighted distribution of the chart points over a sign quality
+type Balance struct {
+	Scores   map[string]float64 `json:"scores"`
+	Dominant string             `json:"dominant"`
+	Missing  []string           `json:"missing,omitempty"` // Qualities without any point
+}
+
+// ChartRulerCondition describes the ruler of the Ascendant and its condition
+type ChartRulerCondition struct {
+	Planet       string             `json:"planet"`
+	Sign         string             `json:"sign"`
+	House        int                `json:"house"`
+	IsRetrograde bool               `json:"is_retrograde"`
+	Essential    EssentialDignity   `json:"essential"`
+	Accidental   *AccidentalDignity `json:"accidental,omitempty"`
+}
+
+// ChartOverview summarises the shape, emphasis and balance of a chart
+type ChartOverview struct {
+	Shape        ChartShape           `json:"shape"`
+	ShapeLeader  string               `json:"shape_leader,omitempty"` // Handle of a bucket, leading planet of a bowl or locomotive
+	Hemispheres  HemisphereEmphasis   `json:"hemispheres"`
+	Elements     Balance              `json:"elements"`
+	Modalities   Balance              `json:"modalities"`
+	Polarities   Balance              `json:"polarities"`
+	ChartRuler   *ChartRulerCondition `json:"chart_ruler,omitempty"`
+	PointWeights map[string]float64   `json:"point_weights"`
+}
+
+// GetDefaultPointWeights returns the default weight of each point in the balances
+func GetDefaultPointWeights() map[string]float64 {
+	return map[string]float64{
+		string(Sun):     4,
+		string(Moon):    4,
+		PointAscendant:  4,
+		PointMidheaven:  2,
+		string(Mercury): 3,
+		string(Venus):   3,
+		string(Mars):    3,
+		string(Jupiter): 2,
+		string(Saturn):  2,
+		string(Uranus):  1,
+		string(Neptune): 1,
+		string(Pluto):   1,
+	}
+}
@@ -0,0 +1,247 @@
+package domain
+
+import "time"
+
+// Angular spans of the panchanga limbs in degrees
+const (
+	TithiSpan     = 12.0        // Sun-Moon elongation per tithi
+	KaranaSpan    = 6.0         // Half a tithi
+	NakshatraSpan = 360.0 / 27  // 13°20' of sidereal Moon longitude
+	YogaSpan      = 360.0 / 27  // 13°20' of the sidereal Sun+Moon sum
+	PadaSpan      = 360.0 / 108 // 3°20', a quarter nakshatra
+)
+
+// Panchanga represents the five limbs of the Hindu almanac for a day
+type Panchanga struct {
+	Date        string            `json:"date"`
+	Location    Location          `json:"location"`
+	Ayanamsa    string            `json:"ayanamsa"`
+	Sunrise     time.Time         `json:"sunrise"`
+	Sunset      time.Time         `json:"sunset"`
+	NextSunrise time.Time         `json:"next_sunrise"`
+	Vara        Vara              `json:"vara"`
+	Tithis      []PanchangaLimb   `json:"tithis"`     // Tithis between sunrise and next sunrise
+	Nakshatras  []PanchangaLimb   `json:"nakshatras"` // Nakshatras between sunrise and next sunrise
+	Yogas       []PanchangaLimb   `json:"yogas"`      // Yogas between sunrise and next sunrise
+	Karanas     []PanchangaLimb   `json:"karanas"`    // Karanas between sunrise and next sunrise
+	RahuKalam   PanchangaInterval `json:"rahu_kalam"`
+	Yamaganda   PanchangaInterval `json:"yamaganda"`
+	Gulika      PanchangaInterval `json:"gulika"`
+}
+
+// PanchangaLimb represents one tithi, nakshatra, yoga or karana with its exact span
+type PanchangaLimb struct {
+	Number int       `json:"number"`           // 1-based index within its cycle
+	Name   string    `json:"name"`             // Sanskrit name
+	Paksha string    `json:"paksha,omitempty"` // Shukla/Krishna (tithis only)
+	Lord   string    `json:"lord,omitempty"`   // Vimshottari lord (nakshatras only)
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+// Vara represents the weekday counted from sunrise
+type Vara struct {
+	Number int    `json:"number"` // 1 = Sunday ... 7 = Saturday
+	Name   string `json:"name"`
+	Lord   string `json:"lord"`
+}
+
+// PanchangaInterval represents an inauspicious period of the day
+type PanchangaInterval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// nakshatraNames lists the 27 lunar mansions starting from 0° Aries (sidereal)
+var nakshatraNames = []string{
+	"Ashwini", "Bharani", "Krittika", "Rohini", "Mrigashira", "Ardra",
+	"Punarvasu", "Pushya", "Ashlesha", "Magha", "Purva Phalguni", "Uttara Phalguni",
+	"Hasta", "Chitra", "Swati", "Vishakha", "Anuradha", "Jyeshtha",
+	"Mula", "Purva Ashadha", "Uttara Ashadha", "Shravana", "Dhanishta", "Shatabhisha",
+	"Purva Bhadrapada", "Uttara Bhadrapada", "Revati",
+}
+
+// vimshottariLords lists the nakshatra lords in Vimshottari order, starting with Ashwini
+var vimshottariLords = []string{
+	"Ketu", "Venus", "Sun", "Moon", "Mars", "Rahu", "Jupiter", "Saturn", "Mercury",
+}
+
+// vimshottariYears contains the dasha period of each Vimshottari lord in years
+var vimshottariYears = map[string]float64{
+	"Ketu": 7, "Venus": 20, "Sun": 6, "Moon": 10, "Mars": 7,
+	"Rahu": 18, "Jupiter": 16, "Saturn": 19, "Mercury": 17,
+}
+
+// tithiNames lists the 15 tithi names of each paksha
+var tithiNames = []string{
+	"Pratipada", "Dwitiya", "Tritiya", "Chaturthi", "Panchami",
+	"Shashthi", "Saptami", "Ashtami", "Navami", "Dashami",
+	"Ekadashi", "Dwadashi", "Trayodashi", "Chaturdashi",
+}
+
+// yogaNames lists the 27 nithya yogas
+var yogaNames = []string{
+	"Vishkambha", "Priti", "Ayushman", "Saubhagya", "Shobhana", "Atiganda",
+	"Sukarma", "Dhriti", "Shula", "Ganda", "Vriddhi", "Dhruva",
+	"Vyaghata", "Harshana", "Vajra", "Siddhi", "Vyatipata", "Variyana",
+	"Parigha", "Shiva", "Siddha", "Sadhya", "Shubha", "Shukla",
+	"Brahma", "Indra", "Vaidhriti",
+}
+
+// movableKaranaNames lists the seven repeating karanas
+var movableKaranaNames = []string{
+	"Bava", "Balava", "Kaulava", "Taitila", "Gara", "Vanija", "Vishti",
+}
+
+// varaNames lists weekday names and lords starting with Sunday
+var varaNames = []Vara{
+	{Number: 1, Name: "Ravivara", Lord: "Sun"},
+	{Number: 2, Name: "Somavara", Lord: "Moon"},
+	{Number: 3, Name: "Mangalavara", Lord: "Mars"},
+	{Number: 4, Name: "Budhavara", Lord: "Mercury"},
+	{Number: 5, Name: "Guruvara", Lord: "Jupiter"},
+	{Number: 6, Name: "Shukravara", Lord: "Venus"},
+	{Number: 7, Name: "Shanivara", Lord: "Saturn"},
+}
+
+// Eighth-of-daytime segment (1-8) of each inauspicious period, indexed by weekday (Sunday first)
+var (
+	rahuKalamSegments = []int{8, 2, 7, 5, 6, 4, 3}
+	yamagandaSegments = []int{5, 4, 3, 2, 1, 7, 6}
+	gulikaSegments    = []int{7, 6, 5, 4, 3, 2, 1}
+)
+
+// GetNakshatraIndex returns the nakshatra index (0-26) of a sidereal longitude
+func GetNakshatraIndex(siderealLongitude float64) int {
+	index := int(normalizeAngle(siderealLongitude) / NakshatraSpan)
+	if index > 26 {
+		index = 26
+	}
+	return index
+}
+
+// GetNakshatraName returns the name of a nakshatra by index (0-26)
+func GetNakshatraName(index int) string {
+	if index < 0 || index > 26 {
+		return ""
+	}
+	return nakshatraNames[index]
+}
+
+// GetNakshatraLord returns the Vimshottari lord of a nakshatra by index (0-26)
+func GetNakshatraLord(index int) string {
+	if index < 0 || index > 26 {
+		return ""
+	}
+	return vimshottariLords[index%9]
+}
+
+// GetNakshatraPada returns the pada (1-4) of a sidereal longitude
+func GetNakshatraPada(siderealLongitude float64) int {
+	return int(normalizeAngle(siderealLongitude)/PadaSpan)%4 + 1
+}
+
+// GetVimshottariSequence returns the Vimshottari lords starting from the given lord
+func GetVimshottariSequence(startLord string) []string {
+	start := 0
+	for i, lord := range vimshottariLords {
+		if lord == startLord {
+			start = i
+			break
+		}
+	}
+
+	sequence := make([]string, 0, len(vimshottariLords))
+	for i := 0; i < len(vimshottariLords); i++ {
+		sequence = append(sequence, vimshottariLords[(start+i)%len(vimshottariLords)])
+	}
+	return sequence
+}
+
+// GetVimshottariYears returns the dasha period of a Vimshottari lord in years (total cycle is 120)
+func GetVimshottariYears(lord string) float64 {
+	return vimshottariYears[lord]
+}
+
+// NewTithi creates a tithi limb by number (1-30)
+func NewTithi(number int, start, end time.Time) PanchangaLimb {
+	limb := PanchangaLimb{Number: number, Start: start, End: end}
+
+	switch {
+	case number == 15:
+		limb.Name, limb.Paksha = "Purnima", "Shukla"
+	case number == 30:
+		limb.Name, limb.Paksha = "Amavasya", "Krishna"
+	case number < 15:
+		limb.Name, limb.Paksha = tithiNames[number-1], "Shukla"
+	default:
+		limb.Name, limb.Paksha = tithiNames[number-16], "Krishna"
+	}
+
+	return limb
+}
+
+// NewNakshatra creates a nakshatra limb by number (1-27)
+func NewNakshatra(number int, start, end time.Time) PanchangaLimb {
+	return PanchangaLimb{
+		Number: number,
+		Name:   GetNakshatraName(number - 1),
+		Lord:   GetNakshatraLord(number - 1),
+		Start:  start,
+		End:    end,
+	}
+}
+
+// NewYoga creates a yoga limb by number (1-27)
+func NewYoga(number int, start, end time.Time) PanchangaLimb {
+	return PanchangaLimb{Number: number, Name: yogaNames[number-1], Start: start, End: end}
+}
+
+// NewKarana creates a karana limb by number (1-60 within the lunar month)
+func NewKarana(number int, start, end time.Time) PanchangaLimb {
+	var name string
+	switch {
+	case number == 1:
+		name = "Kimstughna"
+	case number == 58:
+		name = "Shakuni"
+	case number == 59:
+		name = "Chatushpada"
+	case number == 60:
+		name = "Naga"
+	default:
+		name = movableKaranaNames[(number-2)%7]
+	}
+
+	return PanchangaLimb{Number: number, Name: name, Start: start, End: end}
+}
+
+// GetVara returns the vara for a weekday
+func GetVara(weekday time.Weekday) Vara {
+	return varaNames[int(weekday)]
+}
+
+// GetRahuKalam returns the Rahu Kalam period for a day given sunrise and sunset
+func GetRahuKalam(weekday time.Weekday, sunrise, sunset time.Time) PanchangaInterval {
+	return daytimeSegment(rahuKalamSegments[int(weekday)], sunrise, sunset)
+}
+
+// GetYamaganda returns the Yamaganda period for a day given sunrise and sunset
+func GetYamaganda(weekday time.Weekday, sunrise, sunset time.Time) PanchangaInterval {
+	return daytimeSegment(yamagandaSegments[int(weekday)], sunrise, sunset)
+}
+
+// GetGulika returns the Gulika Kalam period for a day given sunrise and sunset
+func GetGulika(weekday time.Weekday, sunrise, sunset time.Time) PanchangaInterval {
+	return daytimeSegment(gulikaSegments[int(weekday)], sunrise, sunset)
+}
+
+// daytimeSegment returns the n-th (1-8) eighth of the daytime
+func daytimeSegment(segment int, sunrise, sunset time.Time) PanchangaInterval {
+	eighth := sunset.Sub(sunrise) / 8
+	start := sunrise.Add(time.Duration(segment-1) * eighth)
+	return PanchangaInterval{
+		Start: start.Round(time.Second),
+		End:   start.Add(eighth).Round(time.Second),
+	}
+}
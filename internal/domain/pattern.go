@@ -0,0 +1,55 @@
+package domain
+
+// PatternType represents the type of aspect pattern
+type PatternType string
+
+const (
+	PatternGrandTrine      PatternType = "Grand Trine"
+	PatternTSquare         PatternType = "T-Square"
+	PatternGrandCross      PatternType = "Grand Cross"
+	PatternYod             PatternType = "Yod"
+	PatternKite            PatternType = "Kite"
+	PatternMysticRectangle PatternType = "Mystic Rectangle"
+	PatternGrandSextile    PatternType = "Grand Sextile"
+	PatternStelliumBySign  PatternType = "Stellium"
+	PatternStelliumByHouse PatternType = "House Stellium"
+)
+
+// AspectPattern represents a configuration of planets linked by aspects (or grouped by sign/house)
+type AspectPattern struct {
+	Type     PatternType `json:"type"`
+	Planets  []string    `json:"planets"`
+	Apex     string      `json:"apex,omitempty"`     // Focal planet (T-Square, Yod, Kite)
+	Element  string      `json:"element,omitempty"`  // Shared element of all members, if any
+	Modality string      `json:"modality,omitempty"` // Shared modality of all members, if any
+	Sign     string      `json:"sign,omitempty"`     // Sign of a stellium
+	House    int         `json:"house,omitempty"`    // House of a house stellium
+}
+
+// CommonElement returns the element shared by all planets, or "" if they differ
+func CommonElement(planets []Planet) string {
+	if len(planets) == 0 {
+		return ""
+	}
+	element := planets[0].Element
+	for _, planet := range planets[1:] {
+		if planet.Element != element {
+			return ""
+		}
+	}
+	return element
+}
+
+// CommonModality returns the modality shared by all planets, or "" if they differ
+func CommonModality(planets []Planet) string {
+	if len(planets) == 0 {
+		return ""
+	}
+	modality := planets[0].Modality
+	for _, planet := range planets[1:] {
+		if planet.Modality != modality {
+			return ""
+		}
+	}
+	return modality
+}
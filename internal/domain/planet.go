@@ -1,5 +1,7 @@
 package domain
 
+import "strings"
+
 // PlanetName represents planetary names as constants
 type PlanetName string
 
@@ -16,26 +18,35 @@ const (
 	Pluto     PlanetName = "Pluto"
 	NorthNode PlanetName = "North Node"
 	SouthNode PlanetName = "South Node"
+	TrueNode  PlanetName = "True Node"
 	Chiron    PlanetName = "Chiron"
 	Ceres     PlanetName = "Ceres"
 	Pallas    PlanetName = "Pallas"
 	Juno      PlanetName = "Juno"
 	Vesta     PlanetName = "Vesta"
+
+	Lilith           PlanetName = "Lilith"            // Mean Black Moon Lilith
+	OsculatingLilith PlanetName = "Osculating Lilith" // Osculating Black Moon Lilith
 )
 
 // Planet represents a celestial body in an astrological chart
 type Planet struct {
-	Name         string  `json:"name"`
-	Sign         string  `json:"sign"`
-	Degree       string  `json:"degree"`
-	House        int     `json:"house"`
-	Longitude    float64 `json:"longitude"` // Raw longitude in degrees
-	Latitude     float64 `json:"latitude"`  // Raw latitude in degrees (for some calculations)
-	Speed        float64 `json:"speed"`     // Daily motion in degrees
-	IsRetrograde bool    `json:"is_retrograde"`
-	Element      string  `json:"element"`     // fire, earth, air, water
-	Modality     string  `json:"modality"`    // cardinal, fixed, mutable
-	PlanetType   string  `json:"planet_type"` // personal, social, transpersonal, etc.
+	Name            string  `json:"name"`
+	Sign            string  `json:"sign"`
+	Degree          string  `json:"degree"`
+	House           int     `json:"house"`
+	HousePosition   float64 `json:"house_position,omitempty"`   // Fractional house position from longitude and latitude, e.g. 7.83
+	GauquelinSector float64 `json:"gauquelin_sector,omitempty"` // Gauquelin sector, 1 to 36.999 clockwise from the Ascendant
+	Longitude       float64 `json:"longitude"`                  // Raw longitude in degrees
+	Latitude        float64 `json:"latitude"`                   // Raw latitude in degrees (for some calculations)
+	Speed           float64 `json:"speed"`                      // Daily motion in degrees
+	IsRetrograde    bool    `json:"is_retrograde"`
+	RightAscension  float64 `json:"right_ascension"` // Equatorial right ascension in degrees
+	Declination     float64 `json:"declination"`     // Equatorial declination in degrees
+	OutOfBounds     bool    `json:"out_of_bounds"`   // Declination beyond the Sun's greatest declination
+	Element         string  `json:"element"`         // fire, earth, air, water
+	Modality        string  `json:"modality"`        // cardinal, fixed, mutable
+	PlanetType      string  `json:"planet_type"`     // personal, social, transpersonal, etc.
 }
 
 // PlanetType represents the classification of planets
@@ -68,6 +79,12 @@ func NewPlanet(name string, longitude, latitude, speed float64, houseNumber int)
 	return planet
 }
 
+// SetEquatorial sets the equatorial coordinates of the planet
+func (p *Planet) SetEquatorial(rightAscension, declination float64) {
+	p.RightAscension = rightAscension
+	p.Declination = declination
+}
+
 // GetPlanetType returns the type classification for a planet
 func GetPlanetType(planetName string) PlanetType {
 	switch planetName {
@@ -77,15 +94,54 @@ func GetPlanetType(planetName string) PlanetType {
 		return TypeSocial
 	case string(Uranus), string(Neptune), string(Pluto):
 		return TypeTranspersonal
-	case string(NorthNode), string(SouthNode):
+	case string(NorthNode), string(SouthNode), string(TrueNode), string(Lilith), string(OsculatingLilith):
 		return TypeLunar
 	case string(Chiron), string(Ceres), string(Pallas), string(Juno), string(Vesta):
 		return TypeAsteroid
 	default:
+		// Numbered asteroids are named "Asteroid <number>"
+		if strings.HasPrefix(planetName, "Asteroid ") {
+			return TypeAsteroid
+		}
+		if IsLotName(planetName) {
+			return TypeLot
+		}
+		if IsAngleName(planetName) {
+			return TypeAngle
+		}
+		if IsHouseCuspName(planetName) {
+			return TypeHouseCusp
+		}
+		if IsSensitivePointName(planetName) {
+			return TypeSensitivePoint
+		}
 		return TypePersonal
 	}
 }
 
+// GetPlanetNames returns the names of every planet, node, asteroid and Lilith the charts can hold
+func GetPlanetNames() []string {
+	return []string{
+		string(Sun), string(Moon), string(Mercury), string(Venus), string(Mars),
+		string(Jupiter), string(Saturn), string(Uranus), string(Neptune), string(Pluto),
+		string(NorthNode), string(SouthNode), string(TrueNode), string(Chiron),
+		string(Ceres), string(Pallas), string(Juno), string(Vesta),
+		string(Lilith), string(OsculatingLilith),
+	}
+}
+
+// IsKnownBodyName reports whether a name is a chart body: a planet, node, asteroid or Lilith,
+// a numbered asteroid, or a calculated point such as an angle, house cusp or lot
+func IsKnownBodyName(name string) bool {
+	for _, planetName := range GetPlanetNames() {
+		if name == planetName {
+			return true
+		}
+	}
+	return strings.HasPrefix(name, "Asteroid ") || IsLotName(name) || IsAngleName(name) ||
+		IsHouseCuspName(name) || IsSensitivePointName(name)
+}
+
 // IsPersonalPlanet returns true if the planet is a personal planet
 func (p Planet) IsPersonalPlanet() bool {
 	return GetPlanetType(p.Name) == TypePersonal
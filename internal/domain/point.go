@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sensitive point types. Angles and house cusps are aspected like planets but
+// with their own orb rules.
+const (
+	TypeAngle          PlanetType = "angle"
+	TypeHouseCusp      PlanetType = "house_cusp"
+	TypeSensitivePoint PlanetType = "sensitive_point" // Vertex, East Point, co-ascendants and polar ascendant
+)
+
+// Angle point names
+const (
+	PointAscendant  = "Ascendant"
+	PointMidheaven  = "Midheaven"
+	PointDescendant = "Descendant"
+	PointIC         = "IC"
+)
+
+// Sensitive point names
+const (
+	PointVertex              = "Vertex"
+	PointAntiVertex          = "Anti-Vertex"
+	PointEastPoint           = "East Point" // Equatorial Ascendant
+	PointCoAscendantKoch     = "Co-Ascendant (Koch)"
+	PointCoAscendantMunkasey = "Co-Ascendant (Munkasey)"
+	PointPolarAscendant      = "Polar Ascendant"
+)
+
+// houseCuspPrefix prefixes the names of house cusp points, e.g. "Cusp 2"
+const houseCuspPrefix = "Cusp "
+
+// IsAngleName returns true if the name is one of the chart angles
+func IsAngleName(name string) bool {
+	switch name {
+	case PointAscendant, PointMidheaven, PointDescendant, PointIC:
+		return true
+	}
+	return false
+}
+
+// IsSensitivePointName returns true if the name is one of the sensitive points
+func IsSensitivePointName(name string) bool {
+	switch name {
+	case PointVertex, PointAntiVertex, PointEastPoint, PointCoAscendantKoch, PointCoAscendantMunkasey, PointPolarAscendant:
+		return true
+	}
+	return false
+}
+
+// GetHouseCuspName returns the point name of a house cusp
+func GetHouseCuspName(house int) string {
+	return fmt.Sprintf("%s%d", houseCuspPrefix, house)
+}
+
+// IsHouseCuspName returns true if the name is a house cusp point
+func IsHouseCuspName(name string) bool {
+	return strings.HasPrefix(name, houseCuspPrefix)
+}
+
+// GetAnglePoints returns the Ascendant and Midheaven as points for aspect calculations.
+// The Descendant and IC are left out since their aspects mirror those of the Ascendant and MC.
+func (c *Chart) GetAnglePoints() []Planet {
+	if c.Angles.Ascendant.Sign == "" {
+		return nil // Angles not calculated
+	}
+
+	return []Planet{
+		NewPlanet(PointAscendant, c.Angles.Ascendant.Value, 0, c.Angles.Ascendant.Speed, 1),
+		NewPlanet(PointMidheaven, c.Angles.Midheaven.Value, 0, c.Angles.Midheaven.Speed, 10),
+	}
+}
+
+// GetSensitivePoints returns the sensitive points as points for aspect calculations.
+// The Anti-Vertex is left out since its aspects mirror those of the Vertex.
+func (c *Chart) GetSensitivePoints() []Planet {
+	var points []Planet
+	for _, point := range []struct {
+		name  string
+		angle *ChartAngle
+	}{
+		{PointVertex, c.Angles.Vertex},
+		{PointEastPoint, c.Angles.EastPoint},
+		{PointCoAscendantKoch, c.Angles.CoAscendantKoch},
+		{PointCoAscendantMunkasey, c.Angles.CoAscendantMunkasey},
+		{PointPolarAscendant, c.Angles.PolarAscendant},
+	} {
+		if point.angle != nil {
+			points = append(points, NewPlanet(point.name, point.angle.Value, 0, point.angle.Speed, 0))
+		}
+	}
+	return points
+}
+
+// GetHouseCuspPoints returns the intermediate house cusps as points for aspect calculations.
+// Cusps 1, 4, 7 and 10 are left out since they coincide with the angles in quadrant systems.
+func (c *Chart) GetHouseCuspPoints() []Planet {
+	var points []Planet
+	for _, house := range c.Houses {
+		switch house.Number {
+		case 1, 4, 7, 10:
+			continue
+		}
+		points = append(points, NewPlanet(GetHouseCuspName(house.Number), house.CuspValue, 0, house.CuspSpeed, house.Number))
+	}
+	return points
+}
@@ -0,0 +1,40 @@
+package domain
+
+// Shadbala holds the six-fold strength of a planet in virupas (60 virupas = 1 rupa)
+type Shadbala struct {
+	Planet        string     `json:"planet"`
+	SthanaBala    SthanaBala `json:"sthana_bala"`     // Positional strength
+	DigBala       float64    `json:"dig_bala"`        // Directional strength
+	KalaBala      KalaBala   `json:"kala_bala"`       // Temporal strength
+	ChestaBala    float64    `json:"chesta_bala"`     // Motional strength
+	Naisargika    float64    `json:"naisargika_bala"` // Natural strength
+	DrikBala      float64    `json:"drik_bala"`       // Aspectual strength (may be negative)
+	Total         float64    `json:"total"`           // Total in virupas
+	Rupas         float64    `json:"rupas"`           // Total in rupas
+	RequiredRupas float64    `json:"required_rupas"`  // Minimum rupas for the planet to be strong
+	StrengthRatio float64    `json:"strength_ratio"`  // Rupas / required rupas
+	IsStrong      bool       `json:"is_strong"`
+}
+
+// SthanaBala holds the components of positional strength
+type SthanaBala struct {
+	Uccha        float64 `json:"uccha"`        // Exaltation
+	Saptavargaja float64 `json:"saptavargaja"` // Dignity in the seven vargas
+	Ojayugma     float64 `json:"ojayugma"`     // Odd/even sign and navamsa
+	Kendradi     float64 `json:"kendradi"`     // Angular/succedent/cadent house
+	Drekkana     float64 `json:"drekkana"`     // Decanate by planet gender
+	Total        float64 `json:"total"`
+}
+
+// KalaBala holds the components of temporal strength
+type KalaBala struct {
+	Nathonnatha float64 `json:"nathonnatha"` // Day/night
+	Paksha      float64 `json:"paksha"`      // Lunar fortnight
+	Tribhaga    float64 `json:"tribhaga"`    // Third of day/night
+	Abda        float64 `json:"abda"`        // Lord of the year
+	Masa        float64 `json:"masa"`        // Lord of the month
+	Vara        float64 `json:"vara"`        // Lord of the weekday
+	Hora        float64 `json:"hora"`        // Lord of the hour
+	Ayana       float64 `json:"ayana"`       // Declination
+	Total       float64 `json:"total"`
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -92,6 +93,14 @@ func CalculateJulianDay(utcTime time.Time) float64 {
 	return float64(jd) + (decimalHours-12.0)/24.0
 }
 
+// JulianDayToTime converts a Julian Day (UT) back to a UTC time
+func JulianDayToTime(julianDay float64) time.Time {
+	// The Unix epoch 1970-01-01T00:00:00Z is JD 2440587.5
+	seconds := (julianDay - 2440587.5) * 86400.0
+	whole := math.Floor(seconds)
+	return time.Unix(int64(whole), int64((seconds-whole)*1e9)).UTC().Round(time.Second)
+}
+
 // CalculateLocalSiderealTime calculates Local Sidereal Time
 func CalculateLocalSiderealTime(julianDay, longitude float64) float64 {
 	// Days since J2000.0
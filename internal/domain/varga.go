@@ -0,0 +1,194 @@
+package domain
+
+import "math"
+
+// VargaChart represents a Vedic divisional chart (varga) derived from sidereal longitudes
+type VargaChart struct {
+	Division   int              `json:"division"`             // Division number (1 for D1, 9 for D9, ...)
+	Code       string           `json:"code"`                 // Short code, e.g. "D9"
+	Name       string           `json:"name"`                 // Sanskrit name, e.g. "Navamsha"
+	Ascendant  VargaPlacement   `json:"ascendant"`            // Varga lagna
+	Placements []VargaPlacement `json:"placements"`           // Planet placements
+	ChartDraw  string           `json:"chart_draw,omitempty"` // SVG chart
+}
+
+// VargaPlacement represents the placement of a body in a divisional chart
+type VargaPlacement struct {
+	Name              string  `json:"name"`
+	SiderealLongitude float64 `json:"sidereal_longitude"` // Sidereal longitude in the rasi chart
+	Sign              string  `json:"sign"`               // Sign occupied in the varga
+	SignNumber        int     `json:"sign_number"`        // 1-12 for Aries-Pisces
+	House             int     `json:"house"`              // Whole-sign house counted from the varga lagna
+	Ruler             string  `json:"ruler"`              // Lord of the varga sign
+	IsRetrograde      bool    `json:"is_retrograde"`
+}
+
+// VargaInfo contains metadata about a divisional chart
+type VargaInfo struct {
+	Division    int
+	Code        string
+	Name        string
+	Signifies   string
+	Description string
+}
+
+// GetVargaInfos returns information about all supported divisional charts
+func GetVargaInfos() []VargaInfo {
+	return []VargaInfo{
+		{Division: 1, Code: "D1", Name: "Rasi", Signifies: "body, general life"},
+		{Division: 2, Code: "D2", Name: "Hora", Signifies: "wealth"},
+		{Division: 3, Code: "D3", Name: "Drekkana", Signifies: "siblings, courage"},
+		{Division: 4, Code: "D4", Name: "Chaturthamsha", Signifies: "property, fortune"},
+		{Division: 7, Code: "D7", Name: "Saptamsha", Signifies: "children, progeny"},
+		{Division: 9, Code: "D9", Name: "Navamsha", Signifies: "spouse, dharma"},
+		{Division: 10, Code: "D10", Name: "Dashamsha", Signifies: "career, status"},
+		{Division: 12, Code: "D12", Name: "Dwadashamsha", Signifies: "parents"},
+		{Division: 16, Code: "D16", Name: "Shodashamsha", Signifies: "vehicles, comforts"},
+		{Division: 20, Code: "D20", Name: "Vimshamsha", Signifies: "spiritual progress"},
+		{Division: 24, Code: "D24", Name: "Chaturvimshamsha", Signifies: "education, learning"},
+		{Division: 27, Code: "D27", Name: "Saptavimshamsha", Signifies: "strengths and weaknesses"},
+		{Division: 30, Code: "D30", Name: "Trimshamsha", Signifies: "misfortunes"},
+		{Division: 40, Code: "D40", Name: "Khavedamsha", Signifies: "maternal legacy"},
+		{Division: 45, Code: "D45", Name: "Akshavedamsha", Signifies: "paternal legacy, character"},
+		{Division: 60, Code: "D60", Name: "Shashtiamsha", Signifies: "past karma, general"},
+	}
+}
+
+// GetVargaInfo returns information about a specific division
+func GetVargaInfo(division int) *VargaInfo {
+	for _, info := range GetVargaInfos() {
+		if info.Division == division {
+			return &info
+		}
+	}
+	return nil
+}
+
+// IsValidVargaDivision returns true if the division is supported
+func IsValidVargaDivision(division int) bool {
+	return GetVargaInfo(division) != nil
+}
+
+// CalculateVargaSign returns the varga sign index (0-11 for Aries-Pisces) of a sidereal
+// longitude following the Parashari rules for each division
+func CalculateVargaSign(longitude float64, division int) int {
+	lon := normalizeAngle(longitude)
+	sign := int(lon / 30.0)
+	if sign >= 12 {
+		sign = 11
+	}
+	degree := lon - float64(sign)*30.0
+	part := int(degree / (30.0 / float64(division)))
+	if part >= division {
+		part = division - 1
+	}
+
+	isOdd := sign%2 == 0 // Aries (index 0) is an odd sign
+	modality := sign % 3 // 0 movable, 1 fixed, 2 dual
+
+	switch division {
+	case 1:
+		return sign
+	case 2:
+		// Hora: odd signs Sun (Leo) then Moon (Cancer), even signs the reverse
+		if (isOdd && part == 0) || (!isOdd && part == 1) {
+			return 4
+		}
+		return 3
+	case 3:
+		// Drekkana: same sign, 5th and 9th
+		return (sign + part*4) % 12
+	case 4:
+		// Chaturthamsha: same sign, 4th, 7th and 10th
+		return (sign + part*3) % 12
+	case 7, 10:
+		// Odd signs count from the sign itself, even signs from the 7th (D7) or 9th (D10)
+		start := sign
+		if !isOdd {
+			if division == 7 {
+				start = sign + 6
+			} else {
+				start = sign + 8
+			}
+		}
+		return (start + part) % 12
+	case 9, 27:
+		// Continuous count through the zodiac from Aries
+		return int(lon/(30.0/float64(division))) % 12
+	case 12, 60:
+		// Count from the sign itself
+		return (sign + part) % 12
+	case 16, 45:
+		// Movable from Aries, fixed from Leo, dual from Sagittarius
+		starts := []int{0, 4, 8}
+		return (starts[modality] + part) % 12
+	case 20:
+		// Movable from Aries, fixed from Sagittarius, dual from Leo
+		starts := []int{0, 8, 4}
+		return (starts[modality] + part) % 12
+	case 24:
+		// Odd signs from Leo, even signs from Cancer
+		if isOdd {
+			return (4 + part) % 12
+		}
+		return (3 + part) % 12
+	case 30:
+		return trimshamshaSign(degree, isOdd)
+	case 40:
+		// Odd signs from Aries, even signs from Libra
+		if isOdd {
+			return part % 12
+		}
+		return (6 + part) % 12
+	default:
+		// Generic cyclic division for unlisted vargas
+		return int(math.Floor(lon/(30.0/float64(division)))) % 12
+	}
+}
+
+// trimshamshaSign returns the Trimshamsha sign using the unequal Parashari portions
+func trimshamshaSign(degree float64, isOdd bool) int {
+	if isOdd {
+		switch {
+		case degree < 5:
+			return 0 // Aries (Mars)
+		case degree < 10:
+			return 10 // Aquarius (Saturn)
+		case degree < 18:
+			return 8 // Sagittarius (Jupiter)
+		case degree < 25:
+			return 2 // Gemini (Mercury)
+		default:
+			return 6 // Libra (Venus)
+		}
+	}
+
+	switch {
+	case degree < 5:
+		return 1 // Taurus (Venus)
+	case degree < 12:
+		return 5 // Virgo (Mercury)
+	case degree < 20:
+		return 11 // Pisces (Jupiter)
+	case degree < 25:
+		return 9 // Capricorn (Saturn)
+	default:
+		return 7 // Scorpio (Mars)
+	}
+}
+
+// NewVargaPlacement creates a varga placement for a body relative to the varga lagna sign (0-11)
+func NewVargaPlacement(name string, siderealLongitude float64, division int, lagnaSign int, isRetrograde bool) VargaPlacement {
+	signIndex := CalculateVargaSign(siderealLongitude, division)
+	sign := GetSignByNumber(signIndex + 1)
+
+	return VargaPlacement{
+		Name:              name,
+		SiderealLongitude: normalizeAngle(siderealLongitude),
+		Sign:              sign,
+		SignNumber:        signIndex + 1,
+		House:             (signIndex-lagnaSign+12)%12 + 1,
+		Ruler:             GetRulerForSign(sign),
+		IsRetrograde:      isRetrograde,
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AstrocartographyHandler handles astrocartography requests
+type AstrocartographyHandler struct {
+	astrocartographyService *service.AstrocartographyService
+	logger                  *logging.Logger
+}
+
+// NewAstrocartographyHandler creates a new astrocartography handler
+func NewAstrocartographyHandler(astrocartographyService *service.AstrocartographyService, logger *logging.Logger) *AstrocartographyHandler {
+	return &AstrocartographyHandler{
+		astrocartographyService: astrocartographyService,
+		logger:                  logger,
+	}
+}
+
+// HandleAstrocartography handles POST /api/v1/astrocartography
+func (ah *AstrocartographyHandler) HandleAstrocartography(c *gin.Context) {
+	var req service.AstrocartographyRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ah.logger.Error().
+			Err(err).
+			Str("endpoint", "astrocartography").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := ah.astrocartographyService.ValidateAstrocartographyRequest(&req); err != nil {
+		ah.logger.Error().
+			Err(err).
+			Str("endpoint", "astrocartography").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := ah.astrocartographyService.CalculateAstrocartography(&req)
+	if err != nil {
+		ah.logger.Error().
+			Err(err).
+			Str("endpoint", "astrocartography").
+			Str("city", req.City).
+			Msg("Failed to calculate astrocartography")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate astrocartography",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		ah.logger.Debug().
+			Str("endpoint", "astrocartography").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := ah.astrocartographyService.GetAstrocartographyFormatted(&req)
+		if err != nil {
+			ah.logger.Error().
+				Err(err).
+				Str("endpoint", "astrocartography").
+				Msg("Failed to generate LLM-formatted astrocartography")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
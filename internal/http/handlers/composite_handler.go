@@ -39,6 +39,20 @@ func (ch *CompositeHandler) HandleCompositeChart(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := ch.compositeService.ValidateCompositeChartRequest(&req); err != nil {
+		ch.logger.Error().
+			Err(err).
+			Str("endpoint", "composite-chart").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	// Calculate composite chart
 	response, err := ch.compositeService.CalculateCompositeChart(&req)
 	if err != nil {
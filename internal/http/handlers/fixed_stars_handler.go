@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FixedStarsHandler handles fixed star requests
+type FixedStarsHandler struct {
+	fixedStarsService *service.FixedStarsService
+	logger            *logging.Logger
+}
+
+// NewFixedStarsHandler creates a new fixed stars handler
+func NewFixedStarsHandler(fixedStarsService *service.FixedStarsService, logger *logging.Logger) *FixedStarsHandler {
+	return &FixedStarsHandler{
+		fixedStarsService: fixedStarsService,
+		logger:            logger,
+	}
+}
+
+// HandleFixedStars handles POST /api/v1/fixed-stars
+func (fh *FixedStarsHandler) HandleFixedStars(c *gin.Context) {
+	var req service.FixedStarsRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		fh.logger.Error().
+			Err(err).
+			Str("endpoint", "fixed-stars").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := fh.fixedStarsService.ValidateFixedStarsRequest(&req); err != nil {
+		fh.logger.Error().
+			Err(err).
+			Str("endpoint", "fixed-stars").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := fh.fixedStarsService.CalculateFixedStars(&req)
+	if err != nil {
+		fh.logger.Error().
+			Err(err).
+			Str("endpoint", "fixed-stars").
+			Str("city", req.City).
+			Msg("Failed to calculate fixed stars")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate fixed stars",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		fh.logger.Debug().
+			Str("endpoint", "fixed-stars").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := fh.fixedStarsService.GetFixedStarsFormatted(&req)
+		if err != nil {
+			fh.logger.Error().
+				Err(err).
+				Str("endpoint", "fixed-stars").
+				Msg("Failed to generate LLM-formatted fixed stars")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HarmonicsHandler handles harmonic chart requests
+type HarmonicsHandler struct {
+	harmonicsService *service.HarmonicsService
+	logger           *logging.Logger
+}
+
+// NewHarmonicsHandler creates a new harmonics handler
+func NewHarmonicsHandler(harmonicsService *service.HarmonicsService, logger *logging.Logger) *HarmonicsHandler {
+	return &HarmonicsHandler{
+		harmonicsService: harmonicsService,
+		logger:           logger,
+	}
+}
+
+// HandleHarmonicChart handles POST /api/v1/harmonic-chart
+func (hh *HarmonicsHandler) HandleHarmonicChart(c *gin.Context) {
+	var req service.HarmonicChartRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hh.logger.Error().
+			Err(err).
+			Str("endpoint", "harmonic-chart").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := hh.harmonicsService.ValidateHarmonicChartRequest(&req); err != nil {
+		hh.logger.Error().
+			Err(err).
+			Str("endpoint", "harmonic-chart").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := hh.harmonicsService.CalculateHarmonicChart(&req)
+	if err != nil {
+		hh.logger.Error().
+			Err(err).
+			Str("endpoint", "harmonic-chart").
+			Str("city", req.City).
+			Msg("Failed to calculate harmonic chart")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate harmonic chart",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		hh.logger.Debug().
+			Str("endpoint", "harmonic-chart").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := hh.harmonicsService.GetHarmonicChartFormatted(&req)
+		if err != nil {
+			hh.logger.Error().
+				Err(err).
+				Str("endpoint", "harmonic-chart").
+				Msg("Failed to generate LLM-formatted harmonic chart")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
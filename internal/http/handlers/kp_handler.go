@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// KPHandler handles KP analysis requests
+type KPHandler struct {
+	kpService *service.KPService
+	logger    *logging.Logger
+}
+
+// NewKPHandler creates a new KP handler
+func NewKPHandler(kpService *service.KPService, logger *logging.Logger) *KPHandler {
+	return &KPHandler{
+		kpService: kpService,
+		logger:    logger,
+	}
+}
+
+// HandleKPAnalysis handles POST /api/v1/kp-analysis
+func (kh *KPHandler) HandleKPAnalysis(c *gin.Context) {
+	var req service.KPRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		kh.logger.Error().
+			Err(err).
+			Str("endpoint", "kp-analysis").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := kh.kpService.ValidateKPRequest(&req); err != nil {
+		kh.logger.Error().
+			Err(err).
+			Str("endpoint", "kp-analysis").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := kh.kpService.CalculateKPAnalysis(&req)
+	if err != nil {
+		kh.logger.Error().
+			Err(err).
+			Str("endpoint", "kp-analysis").
+			Str("city", req.City).
+			Msg("Failed to calculate KP analysis")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate KP analysis",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		kh.logger.Debug().
+			Str("endpoint", "kp-analysis").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := kh.kpService.GetKPAnalysisFormatted(&req)
+		if err != nil {
+			kh.logger.Error().
+				Err(err).
+				Str("endpoint", "kp-analysis").
+				Msg("Failed to generate LLM-formatted KP analysis")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetKPSubdivisions handles GET /api/v1/kp-subdivisions
+func (kh *KPHandler) GetKPSubdivisions(c *gin.Context) {
+	subdivisions := kh.kpService.GetKPSubdivisions()
+
+	c.JSON(http.StatusOK, gin.H{
+		"subdivisions": subdivisions,
+		"count":        len(subdivisions),
+	})
+}
@@ -39,6 +39,20 @@ func (lrh *LunarReturnHandler) HandleLunarReturn(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := lrh.lunarReturnService.ValidateLunarReturnRequest(&req); err != nil {
+		lrh.logger.Error().
+			Err(err).
+			Str("endpoint", "lunar-return").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	if req.AIResponse {
 		llmText, err := lrh.lunarReturnService.GetLunarReturnFormatted(&req)
 		if err != nil {
@@ -94,6 +108,20 @@ func (lrh *LunarReturnHandler) GetLunarReturnFormatted(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := lrh.lunarReturnService.ValidateLunarReturnRequest(&req); err != nil {
+		lrh.logger.Error().
+			Err(err).
+			Str("endpoint", "lunar-return-formatted").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	llmText, err := lrh.lunarReturnService.GetLunarReturnFormatted(&req)
 	if err != nil {
 		lrh.logger.Error().
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MidpointsHandler handles midpoint and dial requests
+type MidpointsHandler struct {
+	midpointsService *service.MidpointsService
+	logger           *logging.Logger
+}
+
+// NewMidpointsHandler creates a new midpoints handler
+func NewMidpointsHandler(midpointsService *service.MidpointsService, logger *logging.Logger) *MidpointsHandler {
+	return &MidpointsHandler{
+		midpointsService: midpointsService,
+		logger:           logger,
+	}
+}
+
+// HandleMidpoints handles POST /api/v1/midpoints
+func (mh *MidpointsHandler) HandleMidpoints(c *gin.Context) {
+	var req service.MidpointsRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		mh.logger.Error().
+			Err(err).
+			Str("endpoint", "midpoints").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := mh.midpointsService.ValidateMidpointsRequest(&req); err != nil {
+		mh.logger.Error().
+			Err(err).
+			Str("endpoint", "midpoints").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := mh.midpointsService.CalculateMidpoints(&req)
+	if err != nil {
+		mh.logger.Error().
+			Err(err).
+			Str("endpoint", "midpoints").
+			Str("city", req.City).
+			Msg("Failed to calculate midpoints")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate midpoints",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		mh.logger.Debug().
+			Str("endpoint", "midpoints").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := mh.midpointsService.GetMidpointsFormatted(&req)
+		if err != nil {
+			mh.logger.Error().
+				Err(err).
+				Str("endpoint", "midpoints").
+				Msg("Failed to generate LLM-formatted midpoints")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
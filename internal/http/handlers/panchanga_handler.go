@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PanchangaHandler handles panchanga requests
+type PanchangaHandler struct {
+	panchangaService *service.PanchangaService
+	logger           *logging.Logger
+}
+
+// NewPanchangaHandler creates a new panchanga handler
+func NewPanchangaHandler(panchangaService *service.PanchangaService, logger *logging.Logger) *PanchangaHandler {
+	return &PanchangaHandler{
+		panchangaService: panchangaService,
+		logger:           logger,
+	}
+}
+
+// HandlePanchanga handles POST /api/v1/panchanga
+func (ph *PanchangaHandler) HandlePanchanga(c *gin.Context) {
+	var req service.PanchangaRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ph.logger.Error().
+			Err(err).
+			Str("endpoint", "panchanga").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := ph.panchangaService.ValidatePanchangaRequest(&req); err != nil {
+		ph.logger.Error().
+			Err(err).
+			Str("endpoint", "panchanga").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := ph.panchangaService.CalculatePanchanga(&req)
+	if err != nil {
+		ph.logger.Error().
+			Err(err).
+			Str("endpoint", "panchanga").
+			Str("city", req.City).
+			Msg("Failed to calculate panchanga")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate panchanga",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		ph.logger.Debug().
+			Str("endpoint", "panchanga").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := ph.panchangaService.GetPanchangaFormatted(&req)
+		if err != nil {
+			ph.logger.Error().
+				Err(err).
+				Str("endpoint", "panchanga").
+				Msg("Failed to generate LLM-formatted panchanga")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
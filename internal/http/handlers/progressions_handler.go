@@ -39,6 +39,20 @@ func (ph *ProgressionsHandler) HandleProgressions(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := ph.progressionsService.ValidateProgressionsRequest(&req); err != nil {
+		ph.logger.Error().
+			Err(err).
+			Str("endpoint", "progressions").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	if req.AIResponse {
 		llmText, err := ph.progressionsService.GetProgressionsFormatted(&req)
 		if err != nil {
@@ -94,6 +108,20 @@ func (ph *ProgressionsHandler) GetProgressionsFormatted(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := ph.progressionsService.ValidateProgressionsRequest(&req); err != nil {
+		ph.logger.Error().
+			Err(err).
+			Str("endpoint", "progressions-formatted").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	llmText, err := ph.progressionsService.GetProgressionsFormatted(&req)
 	if err != nil {
 		ph.logger.Error().
@@ -39,6 +39,20 @@ func (srh *SolarReturnHandler) HandleSolarReturn(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := srh.solarReturnService.ValidateSolarReturnRequest(&req); err != nil {
+		srh.logger.Error().
+			Err(err).
+			Str("endpoint", "solar-return").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	if req.AIResponse {
 		llmText, err := srh.solarReturnService.GetSolarReturnFormatted(&req)
 		if err != nil {
@@ -94,6 +108,20 @@ func (srh *SolarReturnHandler) GetSolarReturnFormatted(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := srh.solarReturnService.ValidateSolarReturnRequest(&req); err != nil {
+		srh.logger.Error().
+			Err(err).
+			Str("endpoint", "solar-return-formatted").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	llmText, err := srh.solarReturnService.GetSolarReturnFormatted(&req)
 	if err != nil {
 		srh.logger.Error().
@@ -39,6 +39,20 @@ func (sh *SynastryHandler) HandleSynastry(c *gin.Context) {
 		return
 	}
 
+	// Validate request
+	if err := sh.synastryService.ValidateSynastryRequest(&req); err != nil {
+		sh.logger.Error().
+			Err(err).
+			Str("endpoint", "synastry").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	// Calculate synastry
 	response, err := sh.synastryService.CalculateSynastry(&req)
 	if err != nil {
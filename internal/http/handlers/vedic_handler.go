@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"astroeph-api/internal/logging"
+	"astroeph-api/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VedicHandler handles Vedic chart requests
+type VedicHandler struct {
+	vedicService *service.VedicService
+	logger       *logging.Logger
+}
+
+// NewVedicHandler creates a new Vedic chart handler
+func NewVedicHandler(vedicService *service.VedicService, logger *logging.Logger) *VedicHandler {
+	return &VedicHandler{
+		vedicService: vedicService,
+		logger:       logger,
+	}
+}
+
+// HandleVedicChart handles POST /api/v1/vedic-chart
+func (vh *VedicHandler) HandleVedicChart(c *gin.Context) {
+	var req service.VedicChartRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		vh.logger.Error().
+			Err(err).
+			Str("endpoint", "vedic-chart").
+			Msg("Invalid request body")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := vh.vedicService.ValidateVedicChartRequest(&req); err != nil {
+		vh.logger.Error().
+			Err(err).
+			Str("endpoint", "vedic-chart").
+			Msg("Invalid request parameters")
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := vh.vedicService.CalculateVedicChart(&req)
+	if err != nil {
+		vh.logger.Error().
+			Err(err).
+			Str("endpoint", "vedic-chart").
+			Str("city", req.City).
+			Msg("Failed to calculate vedic chart")
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to calculate vedic chart",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if req.AIResponse {
+		vh.logger.Debug().
+			Str("endpoint", "vedic-chart").
+			Msg("🤖 Generating LLM-optimized response")
+
+		llmText, err := vh.vedicService.GetVedicChartFormatted(&req)
+		if err != nil {
+			vh.logger.Error().
+				Err(err).
+				Str("endpoint", "vedic-chart").
+				Msg("Failed to generate LLM-formatted vedic chart")
+			// Continue without formatted response instead of failing
+		} else {
+			response.AIFormattedResponse = &llmText
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -18,6 +18,13 @@ func RegisterRoutes(
 	solarReturnService *service.SolarReturnService,
 	lunarReturnService *service.LunarReturnService,
 	progressionsService *service.ProgressionsService,
+	vedicService *service.VedicService,
+	panchangaService *service.PanchangaService,
+	kpService *service.KPService,
+	fixedStarsService *service.FixedStarsService,
+	midpointsService *service.MidpointsService,
+	harmonicsService *service.HarmonicsService,
+	astrocartographyService *service.AstrocartographyService,
 	logger *logging.Logger,
 ) {
 	// Add logging middleware
@@ -36,6 +43,13 @@ func RegisterRoutes(
 		solarReturnHandler := handlers.NewSolarReturnHandler(solarReturnService, logger)
 		lunarReturnHandler := handlers.NewLunarReturnHandler(lunarReturnService, logger)
 		progressionsHandler := handlers.NewProgressionsHandler(progressionsService, logger)
+		vedicHandler := handlers.NewVedicHandler(vedicService, logger)
+		panchangaHandler := handlers.NewPanchangaHandler(panchangaService, logger)
+		kpHandler := handlers.NewKPHandler(kpService, logger)
+		fixedStarsHandler := handlers.NewFixedStarsHandler(fixedStarsService, logger)
+		midpointsHandler := handlers.NewMidpointsHandler(midpointsService, logger)
+		harmonicsHandler := handlers.NewHarmonicsHandler(harmonicsService, logger)
+		astrocartographyHandler := handlers.NewAstrocartographyHandler(astrocartographyService, logger)
 
 		// Natal chart endpoints
 		v1.POST("/natal-chart", natalHandler.HandleNatalChart)
@@ -55,8 +69,24 @@ func RegisterRoutes(
 		// Progressions endpoints
 		v1.POST("/progressions", progressionsHandler.HandleProgressions)
 
+		// Vedic (sidereal) endpoints
+		v1.POST("/vedic-chart", vedicHandler.HandleVedicChart)
+		v1.POST("/panchanga", panchangaHandler.HandlePanchanga)
+		v1.POST("/kp-analysis", kpHandler.HandleKPAnalysis)
+
+		// Fixed stars endpoints
+		v1.POST("/fixed-stars", fixedStarsHandler.HandleFixedStars)
+
+		// Uranian / Cosmobiology endpoints
+		v1.POST("/midpoints", midpointsHandler.HandleMidpoints)
+		v1.POST("/harmonic-chart", harmonicsHandler.HandleHarmonicChart)
+
+		// Astrocartography endpoints
+		v1.POST("/astrocartography", astrocartographyHandler.HandleAstrocartography)
+
 		// Utility endpoints
 		v1.GET("/house-systems", natalHandler.GetSupportedHouseSystems)
+		v1.GET("/kp-subdivisions", kpHandler.GetKPSubdivisions)
 	}
 }
 
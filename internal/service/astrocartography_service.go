@@ -0,0 +1,265 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"astroeph-api/pkg/geojson"
+	"fmt"
+)
+
+// AstrocartographyService handles astrocartography (ACG) line calculations
+type AstrocartographyService struct {
+	ephemeris                  *astro.Ephemeris
+	planetCalculator           *astro.PlanetCalculator
+	astrocartographyCalculator *astro.AstrocartographyCalculator
+	logger                     *logging.Logger
+}
+
+// NewAstrocartographyService creates a new astrocartography service
+func NewAstrocartographyService(logger *logging.Logger) *AstrocartographyService {
+	ephemeris, err := astro.NewEphemeris(logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to initialize ephemeris for astrocartography service")
+		return nil
+	}
+
+	return &AstrocartographyService{
+		ephemeris:                  ephemeris,
+		planetCalculator:           astro.NewPlanetCalculator(ephemeris),
+		astrocartographyCalculator: astro.NewAstrocartographyCalculator(ephemeris),
+		logger:                     logger,
+	}
+}
+
+// AstrocartographyRequest represents a request for astrocartography lines
+type AstrocartographyRequest struct {
+	Day           int      `json:"day" binding:"required,min=1,max=31"`
+	Month         int      `json:"month" binding:"required,min=1,max=12"`
+	Year          int      `json:"year" binding:"required"`
+	LocalTime     string   `json:"local_time" binding:"required"` // HH:MM:SS format
+	City          string   `json:"city" binding:"required"`
+	Bodies        []string `json:"bodies,omitempty"`          // optional extra bodies, e.g. ["ceres", "true_node"]
+	Angles        []string `json:"angles,omitempty"`          // "MC", "IC", "ASC" and "DSC" (defaults to all four)
+	LatitudeStep  float64  `json:"latitude_step,omitempty"`   // latitude sampling step of the lines in degrees (defaults to 1)
+	NearCity      string   `json:"near_city,omitempty"`       // city whose nearest lines are returned
+	MaxDistanceKm float64  `json:"max_distance_km,omitempty"` // radius of the nearest lines query (defaults to 1000)
+	Parans        bool     `json:"parans,omitempty"`          // whether to find the latitudes where two planets are angular together
+	LocalSpace    bool     `json:"local_space,omitempty"`     // whether to calculate the local space chart of the birth place
+	LocalSpaceOrb float64  `json:"local_space_orb,omitempty"` // bearing orb of the cities along local space lines (defaults to 1)
+	MinPopulation int      `json:"min_population,omitempty"`  // smallest city listed along local space lines (defaults to 1000000, at least 100000)
+	AIResponse    bool     `json:"ai_response,omitempty"`     // whether to format response for LLM
+}
+
+// AstrocartographyResponse represents the response from an astrocartography calculation
+type AstrocartographyResponse struct {
+	BirthInfo           domain.BirthInfo           `json:"birth_info"`
+	Lines               *geojson.FeatureCollection `json:"lines"` // GeoJSON MultiLineString per planet and angle
+	NearCity            *domain.Location           `json:"near_city,omitempty"`
+	NearestLines        []domain.ACGLineDistance   `json:"nearest_lines,omitempty"`
+	Parans              []domain.ACGParan          `json:"parans,omitempty"`
+	LocalSpace          []domain.LocalSpacePlanet  `json:"local_space,omitempty"`
+	LocalSpaceLines     *geojson.FeatureCollection `json:"local_space_lines,omitempty"` // GeoJSON great circle per planet
+	AIFormattedResponse *string                    `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculateAstrocartography calculates the angular lines of the planets for a birth moment
+func (as *AstrocartographyService) CalculateAstrocartography(req *AstrocartographyRequest) (*AstrocartographyResponse, error) {
+	as.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Strs("angles", req.Angles).
+		Str("near_city", req.NearCity).
+		Bool("parans", req.Parans).
+		Bool("local_space", req.LocalSpace).
+		Msg("🔮 Starting astrocartography calculation")
+
+	// Set defaults
+	if req.LatitudeStep <= 0 {
+		req.LatitudeStep = astro.DefaultACGLatitudeStep
+	}
+	if req.MaxDistanceKm <= 0 {
+		req.MaxDistanceKm = astro.DefaultACGMaxDistanceKm
+	}
+	if req.LocalSpaceOrb <= 0 {
+		req.LocalSpaceOrb = astro.DefaultLocalSpaceOrb
+	}
+	if req.MinPopulation <= 0 {
+		req.MinPopulation = astro.DefaultLocalSpaceMinPopulation
+	}
+
+	angles, err := astro.ParseACGAngles(req.Angles)
+	if err != nil {
+		return nil, err
+	}
+
+	geocodingService := astro.GetGeocodingService()
+	if geocodingService == nil {
+		return nil, fmt.Errorf("geocoding service not available")
+	}
+
+	location, err := geocodingService.GetCityInfo(req.City)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location for %s: %w", req.City, err)
+	}
+
+	timeInfo, err := domain.ParseTime(req.Year, req.Month, req.Day, req.LocalTime, location.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	// Houses are not needed for the lines, only right ascension and declination
+	planets, err := as.planetCalculator.CalculatePlanetsWithBodies(timeInfo, nil, req.Bodies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate planets: %w", err)
+	}
+
+	lines := as.astrocartographyCalculator.CalculateLines(timeInfo, planets, angles, req.LatitudeStep)
+
+	response := &AstrocartographyResponse{
+		BirthInfo: domain.BirthInfo{
+			Date:     timeInfo.FormatDateForDisplay(),
+			Time:     timeInfo.FormatTimeOnly(),
+			Location: *location,
+		},
+		Lines: astro.LinesToGeoJSON(lines),
+	}
+
+	// Nearest lines to the requested city
+	if req.NearCity != "" {
+		nearCity, err := geocodingService.GetCityInfo(req.NearCity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get location for %s: %w", req.NearCity, err)
+		}
+		response.NearCity = nearCity
+		response.NearestLines = as.astrocartographyCalculator.FindNearestLines(lines, *nearCity, req.MaxDistanceKm)
+	}
+
+	if req.Parans {
+		response.Parans = as.astrocartographyCalculator.CalculateParans(planets)
+	}
+
+	// Local space lines leave the birth place towards each planet's azimuth
+	if req.LocalSpace {
+		localSpace, err := as.astrocartographyCalculator.CalculateLocalSpace(timeInfo, location, planets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate local space: %w", err)
+		}
+
+		cities, err := geocodingService.GetCitiesByPopulation(req.MinPopulation, astro.LocalSpaceCityLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cities for local space: %w", err)
+		}
+		for i := range localSpace {
+			localSpace[i].Cities = as.astrocartographyCalculator.FindCitiesAlongLine(
+				*location, localSpace[i].Azimuth, cities, req.LocalSpaceOrb)
+		}
+
+		response.LocalSpace = localSpace
+		response.LocalSpaceLines = astro.LocalSpaceToGeoJSON(*location, localSpace)
+	}
+
+	as.logger.Info().
+		Str("endpoint", "astrocartography").
+		Int("lines_calculated", len(lines)).
+		Int("nearest_lines", len(response.NearestLines)).
+		Int("parans", len(response.Parans)).
+		Int("local_space_planets", len(response.LocalSpace)).
+		Msg("✨ Astrocartography calculation completed successfully")
+
+	return response, nil
+}
+
+// GetAstrocartographyFormatted returns formatted astrocartography for LLM consumption
+func (as *AstrocartographyService) GetAstrocartographyFormatted(req *AstrocartographyRequest) (string, error) {
+	response, err := as.CalculateAstrocartography(req)
+	if err != nil {
+		return "", err
+	}
+
+	return as.formatAstrocartographyForLLM(response), nil
+}
+
+// formatAstrocartographyForLLM formats astrocartography results for LLM consumption
+func (as *AstrocartographyService) formatAstrocartographyForLLM(response *AstrocartographyResponse) string {
+	formatted := "ASTROCARTOGRAPHY ANALYSIS\n"
+	formatted += fmt.Sprintf("Birth Date: %s at %s\n", response.BirthInfo.Date, response.BirthInfo.Time)
+	formatted += fmt.Sprintf("Birth Location: %s\n", response.BirthInfo.Location.GetDisplayName())
+	formatted += fmt.Sprintf("Lines calculated: %d\n", len(response.Lines.Features))
+
+	if response.NearCity != nil {
+		formatted += fmt.Sprintf("\nLINES NEAR %s (%s):\n",
+			response.NearCity.GetDisplayName(), response.NearCity.FormatCoordinates())
+		if len(response.NearestLines) == 0 {
+			formatted += "• No planetary line passes nearby\n"
+		}
+		for _, line := range response.NearestLines {
+			formatted += fmt.Sprintf("• %s %s line - %.0f km\n", line.Planet, line.Angle, line.DistanceKm)
+		}
+	}
+
+	if len(response.Parans) > 0 {
+		formatted += "\nPARANS:\n"
+		for _, paran := range response.Parans {
+			formatted += fmt.Sprintf("• %s %s with %s %s at latitude %.2f°\n",
+				paran.Planet1, paran.Angle1, paran.Planet2, paran.Angle2, paran.Latitude)
+		}
+	}
+
+	if len(response.LocalSpace) > 0 {
+		formatted += "\nLOCAL SPACE:\n"
+		for _, planet := range response.LocalSpace {
+			formatted += fmt.Sprintf("• %s: azimuth %.1f° (%s), altitude %.1f°\n",
+				planet.Planet, planet.Azimuth, planet.Direction, planet.Altitude)
+			for _, city := range planet.Cities {
+				direction := "towards"
+				if city.Opposite {
+					direction = "opposite"
+				}
+				formatted += fmt.Sprintf("  - %s, %s: %.0f km %s, bearing %.1f°\n",
+					city.City, city.Country, city.DistanceKm, direction, city.Bearing)
+			}
+		}
+	}
+
+	return formatted
+}
+
+// ValidateAstrocartographyRequest validates an astrocartography request
+func (as *AstrocartographyService) ValidateAstrocartographyRequest(req *AstrocartographyRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.City == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	if _, err := astro.ParseBodies(req.Bodies); err != nil {
+		return err
+	}
+
+	if _, err := astro.ParseACGAngles(req.Angles); err != nil {
+		return err
+	}
+
+	if req.LatitudeStep != 0 && (req.LatitudeStep < 0.1 || req.LatitudeStep > 5) {
+		return fmt.Errorf("latitude_step must be between 0.1 and 5 degrees")
+	}
+
+	if req.MaxDistanceKm > 5000 {
+		return fmt.Errorf("max_distance_km must be at most 5000")
+	}
+
+	if req.LocalSpaceOrb > 5 {
+		return fmt.Errorf("local_space_orb must be at most 5 degrees")
+	}
+
+	if req.MinPopulation != 0 && req.MinPopulation < astro.MinLocalSpacePopulation {
+		return fmt.Errorf("min_population must be at least %d", astro.MinLocalSpacePopulation)
+	}
+
+	return nil
+}
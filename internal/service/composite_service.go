@@ -30,6 +30,7 @@ func NewCompositeService(logger *logging.Logger) *CompositeService {
 type CompositeChartRequest struct {
 	Person1    PersonData `json:"person1" binding:"required"`
 	Person2    PersonData `json:"person2" binding:"required"`
+	Bodies     []string   `json:"bodies,omitempty"` // optional extra bodies for both charts, e.g. ["ceres", "lilith"]
 	DrawChart  bool       `json:"draw_chart,omitempty"`
 	SVGWidth   int        `json:"svg_width,omitempty"`
 	SVGTheme   string     `json:"svg_theme,omitempty"`
@@ -57,6 +58,7 @@ func (cs *CompositeService) CalculateCompositeChart(req *CompositeChartRequest)
 	synastryReq := &SynastryRequest{
 		Person1:   req.Person1,
 		Person2:   req.Person2,
+		Bodies:    req.Bodies,
 		DrawChart: false,
 	}
 
@@ -141,6 +143,18 @@ func (cs *CompositeService) calculateComposite(chart1, chart2 *domain.Chart) *do
 	for _, aspect := range aspects {
 		composite.AddAspect(aspect)
 	}
+	composite.Patterns = aspectCalc.CalculateAspectPatterns(composite.Aspects, compositePlanets)
+
+	// Chart shape, hemispheres, balances and chart ruler with the default settings
+	overviewCalc := astro.NewOverviewCalculator()
+	composite.Overview = overviewCalc.CalculateOverview(
+		compositePlanets,
+		ascMidpoint,
+		mcMidpoint,
+		nil,
+		domain.DefaultDignitySettings(),
+		astro.IsNightChart(compositePlanets, ascMidpoint),
+	)
 
 	return composite
 }
@@ -284,6 +298,9 @@ func (cs *CompositeService) formatCompositeForLLM(response *CompositeChartRespon
 	formatted += fmt.Sprintf("• Ascendant: %s %s\n", composite.Angles.Ascendant.Degree, composite.Angles.Ascendant.Sign)
 	formatted += fmt.Sprintf("• Midheaven: %s %s\n", composite.Angles.Midheaven.Degree, composite.Angles.Midheaven.Sign)
 
+	// Composite overview
+	formatted += formatChartOverview(composite.Overview)
+
 	// Composite aspects
 	if len(composite.Aspects) > 0 {
 		formatted += "\nCOMPOSITE ASPECTS:\n"
@@ -303,3 +320,12 @@ func (cs *CompositeService) formatCompositeForLLM(response *CompositeChartRespon
 
 	return formatted
 }
+
+// ValidateCompositeChartRequest validates a composite chart request
+func (cs *CompositeService) ValidateCompositeChartRequest(req *CompositeChartRequest) error {
+	return cs.synastryService.ValidateSynastryRequest(&SynastryRequest{
+		Person1: req.Person1,
+		Person2: req.Person2,
+		Bodies:  req.Bodies,
+	})
+}
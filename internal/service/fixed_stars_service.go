@@ -0,0 +1,250 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"fmt"
+	"strings"
+)
+
+// FixedStarsService handles fixed star calculations
+type FixedStarsService struct {
+	ephemeris           *astro.Ephemeris
+	planetCalculator    *astro.PlanetCalculator
+	houseCalculator     *astro.HouseCalculator
+	fixedStarCalculator *astro.FixedStarCalculator
+	logger              *logging.Logger
+}
+
+// NewFixedStarsService creates a new fixed stars service
+func NewFixedStarsService(logger *logging.Logger) *FixedStarsService {
+	ephemeris, err := astro.NewEphemeris(logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to initialize ephemeris for fixed stars service")
+		return nil
+	}
+
+	return &FixedStarsService{
+		ephemeris:           ephemeris,
+		planetCalculator:    astro.NewPlanetCalculator(ephemeris),
+		houseCalculator:     astro.NewHouseCalculator(ephemeris),
+		fixedStarCalculator: astro.NewFixedStarCalculator(ephemeris),
+		logger:              logger,
+	}
+}
+
+// FixedStarsRequest represents a request for fixed star positions and natal contacts
+type FixedStarsRequest struct {
+	Day            int      `json:"day" binding:"required,min=1,max=31"`
+	Month          int      `json:"month" binding:"required,min=1,max=12"`
+	Year           int      `json:"year" binding:"required"`
+	LocalTime      string   `json:"local_time" binding:"required"` // HH:MM:SS format
+	City           string   `json:"city" binding:"required"`
+	Stars          []string `json:"stars,omitempty"`           // star names from sefstars.txt, e.g. ["Sirius", "Polaris"]
+	Preset         string   `json:"preset,omitempty"`          // "behenian" or "royal"; defaults to both when no stars are given
+	ConjunctionOrb float64  `json:"conjunction_orb,omitempty"` // degrees (defaults to 1)
+	ParanOrb       float64  `json:"paran_orb,omitempty"`       // minutes of time (defaults to 4)
+	AIResponse     bool     `json:"ai_response,omitempty"`     // whether to format response for LLM
+}
+
+// FixedStarsResponse represents the response from a fixed star calculation
+type FixedStarsResponse struct {
+	BirthInfo           domain.BirthInfo         `json:"birth_info"`
+	Stars               []domain.FixedStar       `json:"stars"`
+	Conjunctions        []domain.StarConjunction `json:"conjunctions"`
+	Parans              []domain.StarParan       `json:"parans"`
+	AngularStars        []domain.AngularStar     `json:"angular_stars"`      // Stars rising, setting or culminating at birth
+	Warnings            []string                 `json:"warnings,omitempty"` // Fallbacks applied during the calculation
+	AIFormattedResponse *string                  `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculateFixedStars calculates star positions, natal conjunctions, parans and angular stars
+func (fs *FixedStarsService) CalculateFixedStars(req *FixedStarsRequest) (*FixedStarsResponse, error) {
+	fs.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Strs("stars", req.Stars).
+		Str("preset", req.Preset).
+		Msg("🔮 Starting fixed stars calculation")
+
+	// Set defaults
+	if req.ConjunctionOrb <= 0 {
+		req.ConjunctionOrb = astro.DefaultStarConjunctionOrb
+	}
+	if req.ParanOrb <= 0 {
+		req.ParanOrb = astro.DefaultStarParanOrb
+	}
+
+	geocodingService := astro.GetGeocodingService()
+	if geocodingService == nil {
+		return nil, fmt.Errorf("geocoding service not available")
+	}
+
+	location, err := geocodingService.GetCityInfo(req.City)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location for %s: %w", req.City, err)
+	}
+
+	timeInfo, err := domain.ParseTime(req.Year, req.Month, req.Day, req.LocalTime, location.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	julianDay := fs.ephemeris.GetJulianDay(timeInfo)
+	tz := timeInfo.LocalTime.Location()
+
+	stars, err := fs.fixedStarCalculator.CalculateStarPositions(julianDay, fs.resolveStarList(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate fixed stars: %w", err)
+	}
+
+	_, housesData, err := fs.houseCalculator.CalculateHousesWithFallback(
+		timeInfo, location, domain.HousePlacidus, domain.HousePorphyrius)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate angles: %w", err)
+	}
+
+	planets, err := fs.planetCalculator.CalculateAllPlanets(timeInfo, housesData.Cusps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate planets: %w", err)
+	}
+
+	parans, err := fs.fixedStarCalculator.FindParans(
+		fs.ephemeris.GetLocalMidnightJulianDay(timeInfo), stars, location, req.ParanOrb, tz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate parans: %w", err)
+	}
+
+	angularStars, err := fs.fixedStarCalculator.FindAngularStars(julianDay, stars, location, req.ParanOrb, tz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate angular stars: %w", err)
+	}
+
+	response := &FixedStarsResponse{
+		BirthInfo: domain.BirthInfo{
+			Date:     timeInfo.FormatDateForDisplay(),
+			Time:     timeInfo.FormatTimeOnly(),
+			Location: *location,
+		},
+		Stars: stars,
+		Conjunctions: fs.fixedStarCalculator.FindConjunctions(
+			stars, planets, housesData.Ascendant, housesData.Midheaven, req.ConjunctionOrb),
+		Parans:       parans,
+		AngularStars: angularStars,
+	}
+	if warning := astro.GetPolarFallbackWarning(domain.HousePlacidus, housesData, location.Latitude); warning != "" {
+		response.Warnings = append(response.Warnings, warning)
+	}
+
+	fs.logger.Info().
+		Str("endpoint", "fixed-stars").
+		Int("stars_calculated", len(response.Stars)).
+		Int("conjunctions_found", len(response.Conjunctions)).
+		Int("parans_found", len(response.Parans)).
+		Msg("✨ Fixed stars calculation completed successfully")
+
+	return response, nil
+}
+
+// resolveStarList combines the requested stars and preset without duplicates
+func (fs *FixedStarsService) resolveStarList(req *FixedStarsRequest) []string {
+	names := append([]string(nil), req.Stars...)
+	if req.Preset != "" {
+		names = append(names, domain.GetStarPreset(req.Preset)...)
+	}
+	if len(names) == 0 {
+		for _, preset := range domain.GetAvailableStarPresets() {
+			names = append(names, domain.GetStarPreset(preset)...)
+		}
+	}
+
+	var stars []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if key != "" && !seen[key] {
+			seen[key] = true
+			stars = append(stars, strings.TrimSpace(name))
+		}
+	}
+	return stars
+}
+
+// GetFixedStarsFormatted returns formatted fixed star contacts for LLM consumption
+func (fs *FixedStarsService) GetFixedStarsFormatted(req *FixedStarsRequest) (string, error) {
+	response, err := fs.CalculateFixedStars(req)
+	if err != nil {
+		return "", err
+	}
+
+	return fs.formatFixedStarsForLLM(response), nil
+}
+
+// formatFixedStarsForLLM formats fixed star contacts for LLM consumption
+func (fs *FixedStarsService) formatFixedStarsForLLM(response *FixedStarsResponse) string {
+	formatted := "FIXED STARS ANALYSIS\n"
+	formatted += fmt.Sprintf("Birth Date: %s at %s\n", response.BirthInfo.Date, response.BirthInfo.Time)
+	formatted += fmt.Sprintf("Birth Location: %s\n", response.BirthInfo.Location.GetDisplayName())
+	for _, warning := range response.Warnings {
+		formatted += fmt.Sprintf("Warning: %s\n", warning)
+	}
+	formatted += "\n"
+
+	formatted += "STAR POSITIONS:\n"
+	for _, star := range response.Stars {
+		formatted += fmt.Sprintf("• %s: %s %s (magnitude %.2f)\n", star.Name, star.Degree, star.Sign, star.Magnitude)
+	}
+
+	if len(response.Conjunctions) > 0 {
+		formatted += "\nCONJUNCTIONS:\n"
+		for _, conjunction := range response.Conjunctions {
+			formatted += fmt.Sprintf("• %s conjunct %s (orb %.2f°)\n", conjunction.Star, conjunction.Point, conjunction.Orb)
+		}
+	}
+
+	if len(response.Parans) > 0 {
+		formatted += "\nPARANS:\n"
+		for _, paran := range response.Parans {
+			formatted += fmt.Sprintf("• %s %s while %s %s (%s, %.1f min)\n",
+				paran.Star, paran.StarEvent, paran.Planet, paran.Event, paran.Time.Format("15:04"), paran.Minutes)
+		}
+	}
+
+	if len(response.AngularStars) > 0 {
+		formatted += "\nSTARS ON THE ANGLES AT BIRTH:\n"
+		for _, star := range response.AngularStars {
+			formatted += fmt.Sprintf("• %s %s (%.1f min from birth)\n", star.Star, star.Event, star.Minutes)
+		}
+	}
+
+	return formatted
+}
+
+// ValidateFixedStarsRequest validates a fixed stars request
+func (fs *FixedStarsService) ValidateFixedStarsRequest(req *FixedStarsRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.City == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	if req.Preset != "" && domain.GetStarPreset(req.Preset) == nil {
+		return fmt.Errorf("invalid preset: %s (use %s)", req.Preset,
+			strings.Join(domain.GetAvailableStarPresets(), " or "))
+	}
+
+	if req.ConjunctionOrb > 10 {
+		return fmt.Errorf("conjunction_orb must be at most 10 degrees")
+	}
+
+	if req.ParanOrb > 60 {
+		return fmt.Errorf("paran_orb must be at most 60 minutes")
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HarmonicsService handles harmonic chart calculations
+type HarmonicsService struct {
+	natalService       *NatalService
+	harmonicCalculator *astro.HarmonicCalculator
+	chartDrawer        *astro.ChartDrawer
+	logger             *logging.Logger
+}
+
+// NewHarmonicsService creates a new harmonics service
+func NewHarmonicsService(logger *logging.Logger) *HarmonicsService {
+	natalService := NewNatalService(logger)
+	if natalService == nil {
+		logger.Error().Msg("Failed to initialize natal service for harmonics service")
+		return nil
+	}
+
+	return &HarmonicsService{
+		natalService:       natalService,
+		harmonicCalculator: astro.NewHarmonicCalculator(),
+		chartDrawer:        astro.NewChartDrawer(),
+		logger:             logger,
+	}
+}
+
+// HarmonicChartRequest represents a request for a harmonic chart
+type HarmonicChartRequest struct {
+	Day         int     `json:"day" binding:"required,min=1,max=31"`
+	Month       int     `json:"month" binding:"required,min=1,max=12"`
+	Year        int     `json:"year" binding:"required"`
+	LocalTime   string  `json:"local_time" binding:"required"` // HH:MM:SS format
+	City        string  `json:"city" binding:"required"`
+	HouseSystem string  `json:"house_system,omitempty"` // natal house system (defaults to "Placidus")
+	Harmonic    float64 `json:"harmonic,omitempty"`     // harmonic number, e.g. 5 or 7
+	AgeHarmonic bool    `json:"age_harmonic,omitempty"` // use the current age as the harmonic
+	AgeDate     string  `json:"age_date,omitempty"`     // YYYY-MM-DD for the age harmonic (defaults to today)
+	Spectrum    int     `json:"spectrum,omitempty"`     // highest harmonic in the spectrum summary (defaults to 32)
+	DrawChart   bool    `json:"draw_chart,omitempty"`   // whether to generate SVG chart
+	SVGWidth    int     `json:"svg_width,omitempty"`    // width of SVG chart (defaults to 600)
+	SVGTheme    string  `json:"svg_theme,omitempty"`    // theme for SVG chart ("light", "dark", "mono")
+	AIResponse  bool    `json:"ai_response,omitempty"`  // whether to format response for LLM
+}
+
+// HarmonicChartResponse represents the response from a harmonic chart calculation
+type HarmonicChartResponse struct {
+	Harmonic            float64                  `json:"harmonic"`
+	IsAgeHarmonic       bool                     `json:"is_age_harmonic"`
+	HarmonicChart       *domain.Chart            `json:"harmonic_chart"`
+	Spectrum            *domain.HarmonicSpectrum `json:"spectrum"`
+	AIFormattedResponse *string                  `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculateHarmonicChart calculates a harmonic chart and the harmonic spectrum of a natal chart
+func (hs *HarmonicsService) CalculateHarmonicChart(req *HarmonicChartRequest) (*HarmonicChartResponse, error) {
+	hs.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Float64("harmonic", req.Harmonic).
+		Bool("age_harmonic", req.AgeHarmonic).
+		Msg("🔮 Starting harmonic chart calculation")
+
+	// Set defaults
+	if req.Spectrum <= 0 {
+		req.Spectrum = astro.DefaultSpectrumHarmonics
+	}
+	if req.SVGWidth <= 0 && req.DrawChart {
+		req.SVGWidth = 600
+	}
+
+	natalResponse, err := hs.natalService.CalculateNatalChart(&NatalChartRequest{
+		Day:         req.Day,
+		Month:       req.Month,
+		Year:        req.Year,
+		LocalTime:   req.LocalTime,
+		City:        req.City,
+		HouseSystem: req.HouseSystem,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate natal chart: %w", err)
+	}
+	natalChart := natalResponse.Chart
+
+	harmonic := req.Harmonic
+	if req.AgeHarmonic {
+		ageDate, err := parseAgeDate(req.AgeDate)
+		if err != nil {
+			return nil, err
+		}
+
+		harmonic, err = hs.harmonicCalculator.CalculateAgeHarmonic(natalChart, ageDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate age harmonic: %w", err)
+		}
+	}
+
+	harmonicChart := hs.harmonicCalculator.CalculateHarmonicChart(natalChart, harmonic)
+
+	// Generate SVG chart if requested
+	if req.DrawChart {
+		theme := hs.chartDrawer.GetThemeFromString(req.SVGTheme)
+		svg, err := hs.chartDrawer.GenerateNatalChart(harmonicChart, req.SVGWidth, theme)
+		if err != nil {
+			hs.logger.Error().
+				Err(err).
+				Msg("Failed to generate harmonic chart SVG")
+			// Don't fail the entire request if SVG generation fails
+		} else {
+			harmonicChart.ChartDraw = svg
+		}
+	}
+
+	response := &HarmonicChartResponse{
+		Harmonic:      harmonic,
+		IsAgeHarmonic: req.AgeHarmonic,
+		HarmonicChart: harmonicChart,
+		Spectrum:      hs.harmonicCalculator.CalculateSpectrum(natalChart, req.Spectrum),
+	}
+
+	hs.logger.Info().
+		Str("endpoint", "harmonic-chart").
+		Float64("harmonic", harmonic).
+		Int("aspects_found", len(harmonicChart.Aspects)).
+		Ints("strongest_harmonics", response.Spectrum.Strongest).
+		Msg("✨ Harmonic chart calculation completed successfully")
+
+	return response, nil
+}
+
+// GetHarmonicChartFormatted returns a formatted harmonic chart for LLM consumption
+func (hs *HarmonicsService) GetHarmonicChartFormatted(req *HarmonicChartRequest) (string, error) {
+	response, err := hs.CalculateHarmonicChart(req)
+	if err != nil {
+		return "", err
+	}
+
+	return hs.formatHarmonicChartForLLM(response), nil
+}
+
+// formatHarmonicChartForLLM formats a harmonic chart for LLM consumption
+func (hs *HarmonicsService) formatHarmonicChartForLLM(response *HarmonicChartResponse) string {
+	chart := response.HarmonicChart
+
+	formatted := "HARMONIC CHART ANALYSIS\n"
+	formatted += fmt.Sprintf("Birth Date: %s at %s\n", chart.BirthInfo.Date, chart.BirthInfo.Time)
+	formatted += fmt.Sprintf("Birth Location: %s\n", chart.BirthInfo.Location.GetDisplayName())
+	if response.IsAgeHarmonic {
+		formatted += fmt.Sprintf("Age Harmonic: %.2f\n\n", response.Harmonic)
+	} else {
+		formatted += fmt.Sprintf("Harmonic: %g\n\n", response.Harmonic)
+	}
+
+	formatted += "HARMONIC POSITIONS:\n"
+	for _, planet := range chart.Planets {
+		formatted += fmt.Sprintf("• %s: %s %s\n", planet.Name, planet.Degree, planet.Sign)
+	}
+	formatted += fmt.Sprintf("• Ascendant: %s %s\n", chart.Angles.Ascendant.Degree, chart.Angles.Ascendant.Sign)
+	formatted += fmt.Sprintf("• Midheaven: %s %s\n", chart.Angles.Midheaven.Degree, chart.Angles.Midheaven.Sign)
+
+	if len(chart.Aspects) > 0 {
+		formatted += "\nHARMONIC ASPECTS:\n"
+		for _, aspect := range chart.Aspects {
+			formatted += fmt.Sprintf("• %s %s %s - %.1f° orb\n",
+				aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+		}
+	}
+
+	if len(chart.Patterns) > 0 {
+		formatted += "\nPATTERNS:\n"
+		for _, pattern := range chart.Patterns {
+			formatted += fmt.Sprintf("• %s: %s\n", pattern.Type, strings.Join(pattern.Planets, ", "))
+		}
+	}
+
+	formatted += "\nHARMONIC SPECTRUM (strongest first):\n"
+	for _, harmonic := range response.Spectrum.Strongest {
+		strength := response.Spectrum.Harmonics[harmonic-1]
+		formatted += fmt.Sprintf("• H%d: amplitude %.2f, %d conjunctions\n",
+			strength.Harmonic, strength.Amplitude, strength.Conjunctions)
+	}
+
+	return formatted
+}
+
+// ValidateHarmonicChartRequest validates a harmonic chart request
+func (hs *HarmonicsService) ValidateHarmonicChartRequest(req *HarmonicChartRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.City == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	if !req.AgeHarmonic && (req.Harmonic < 1 || req.Harmonic > 360) {
+		return fmt.Errorf("harmonic must be between 1 and 360 (or use age_harmonic)")
+	}
+
+	if req.AgeDate != "" && !req.AgeHarmonic {
+		return fmt.Errorf("age_date requires age_harmonic")
+	}
+
+	// The age harmonic must be at least 1, like an explicit harmonic
+	if req.AgeHarmonic {
+		ageDate, err := parseAgeDate(req.AgeDate)
+		if err != nil {
+			return err
+		}
+		birthDate := time.Date(req.Year, time.Month(req.Month), req.Day, 0, 0, 0, 0, time.UTC)
+		if ageDate.Before(birthDate.AddDate(1, 0, 0)) {
+			return fmt.Errorf("age_date must be at least one year after the birth date")
+		}
+	}
+
+	if req.Spectrum > 180 {
+		return fmt.Errorf("spectrum must be at most 180")
+	}
+
+	if req.HouseSystem != "" && !astro.IsValidHouseSystem(req.HouseSystem) {
+		return fmt.Errorf("invalid house system: %s", req.HouseSystem)
+	}
+
+	return nil
+}
+
+// parseAgeDate parses the YYYY-MM-DD date of the age harmonic; an empty date is today
+func parseAgeDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now().UTC(), nil
+	}
+
+	ageDate, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid age_date: %w", err)
+	}
+	return ageDate, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"fmt"
+	"strings"
+)
+
+// KPService handles Krishnamurti Paddhati (KP) calculations
+type KPService struct {
+	ephemeris    *astro.Ephemeris
+	kpCalculator *astro.KPCalculator
+	logger       *logging.Logger
+}
+
+// NewKPService creates a new KP service
+func NewKPService(logger *logging.Logger) *KPService {
+	ephemeris, err := astro.NewEphemeris(logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to initialize ephemeris for KP service")
+		return nil
+	}
+
+	return &KPService{
+		ephemeris:    ephemeris,
+		kpCalculator: astro.NewKPCalculator(ephemeris),
+		logger:       logger,
+	}
+}
+
+// KPRequest represents a request for a KP analysis
+type KPRequest struct {
+	Day        int    `json:"day" binding:"required,min=1,max=31"`
+	Month      int    `json:"month" binding:"required,min=1,max=12"`
+	Year       int    `json:"year" binding:"required"`
+	LocalTime  string `json:"local_time" binding:"required"` // HH:MM:SS format
+	City       string `json:"city" binding:"required"`
+	AIResponse bool   `json:"ai_response,omitempty"` // whether to format response for LLM
+}
+
+// KPResponse represents the response from a KP analysis
+type KPResponse struct {
+	BirthInfo domain.BirthInfo `json:"birth_info"`
+	*domain.KPChart
+	AIFormattedResponse *string `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculateKPAnalysis calculates KP cusps, planet lords and house significators
+func (ks *KPService) CalculateKPAnalysis(req *KPRequest) (*KPResponse, error) {
+	ks.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Msg("🔮 Starting KP analysis")
+
+	geocodingService := astro.GetGeocodingService()
+	if geocodingService == nil {
+		return nil, fmt.Errorf("geocoding service not available")
+	}
+
+	location, err := geocodingService.GetCityInfo(req.City)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location for %s: %w", req.City, err)
+	}
+
+	timeInfo, err := domain.ParseTime(req.Year, req.Month, req.Day, req.LocalTime, location.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	kpChart, err := ks.kpCalculator.CalculateKPChart(timeInfo, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate KP chart: %w", err)
+	}
+
+	ks.logger.Info().
+		Str("endpoint", "kp-analysis").
+		Float64("ayanamsa_value", kpChart.AyanamsaValue).
+		Int("cusps_calculated", len(kpChart.Cusps)).
+		Int("planets_calculated", len(kpChart.Planets)).
+		Msg("✨ KP analysis completed successfully")
+
+	return &KPResponse{
+		BirthInfo: domain.BirthInfo{
+			Date:     timeInfo.FormatDateForDisplay(),
+			Time:     timeInfo.FormatTimeOnly(),
+			Location: *location,
+		},
+		KPChart: kpChart,
+	}, nil
+}
+
+// GetKPSubdivisions returns the 249 KP sub divisions of the zodiac
+func (ks *KPService) GetKPSubdivisions() []domain.KPSubdivision {
+	return domain.GetKPSubdivisions()
+}
+
+// GetKPAnalysisFormatted returns a formatted KP analysis for LLM consumption
+func (ks *KPService) GetKPAnalysisFormatted(req *KPRequest) (string, error) {
+	response, err := ks.CalculateKPAnalysis(req)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.formatKPAnalysisForLLM(response), nil
+}
+
+// formatKPAnalysisForLLM formats a KP analysis for LLM consumption
+func (ks *KPService) formatKPAnalysisForLLM(response *KPResponse) string {
+	formatted := "KRISHNAMURTI PADDHATI (KP) ANALYSIS\n"
+	formatted += fmt.Sprintf("Birth Date: %s at %s\n", response.BirthInfo.Date, response.BirthInfo.Time)
+	formatted += fmt.Sprintf("Birth Location: %s\n", response.BirthInfo.Location.GetDisplayName())
+	formatted += fmt.Sprintf("Ayanamsa: %s (%.4f°)\n", response.Ayanamsa, response.AyanamsaValue)
+	for _, warning := range response.Warnings {
+		formatted += fmt.Sprintf("Warning: %s\n", warning)
+	}
+	formatted += "\n"
+
+	formatted += "CUSPS (SIGN / STAR / SUB / SUB-SUB LORDS):\n"
+	for _, cusp := range response.Cusps {
+		formatted += fmt.Sprintf("• Cusp %d: %s %s - %s / %s / %s / %s\n",
+			cusp.House, cusp.Degree, cusp.Sign, cusp.SignLord, cusp.StarLord, cusp.SubLord, cusp.SubSubLord)
+	}
+
+	formatted += "\nPLANETS (SIGN / STAR / SUB / SUB-SUB LORDS):\n"
+	for _, planet := range response.Planets {
+		retrograde := ""
+		if planet.IsRetrograde {
+			retrograde = " (R)"
+		}
+		formatted += fmt.Sprintf("• %s: %s %s%s (House %d) - %s / %s / %s / %s\n",
+			planet.Name, planet.Degree, planet.Sign, retrograde, planet.House,
+			planet.SignLord, planet.StarLord, planet.SubLord, planet.SubSubLord)
+	}
+
+	formatted += "\nHOUSE SIGNIFICATORS (LEVELS 1-4):\n"
+	for _, sig := range response.Significators {
+		formatted += fmt.Sprintf("• House %d: [%s] [%s] [%s] [%s]\n", sig.House,
+			strings.Join(sig.Level1, ", "), strings.Join(sig.Level2, ", "),
+			strings.Join(sig.Level3, ", "), strings.Join(sig.Level4, ", "))
+	}
+
+	return formatted
+}
+
+// ValidateKPRequest validates a KP analysis request
+func (ks *KPService) ValidateKPRequest(req *KPRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.City == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	return nil
+}
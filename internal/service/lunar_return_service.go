@@ -38,11 +38,12 @@ type LunarReturnRequest struct {
 	ReturnCity  string `json:"return_city,omitempty"` // If different from birth city
 
 	// Chart options
-	HouseSystem string `json:"house_system,omitempty"`
-	DrawChart   bool   `json:"draw_chart,omitempty"`
-	SVGWidth    int    `json:"svg_width,omitempty"`
-	SVGTheme    string `json:"svg_theme,omitempty"`
-	AIResponse  bool   `json:"ai_response,omitempty"`
+	HouseSystem string   `json:"house_system,omitempty"`
+	Bodies      []string `json:"bodies,omitempty"` // optional extra bodies for both charts, e.g. ["ceres", "lilith"]
+	DrawChart   bool     `json:"draw_chart,omitempty"`
+	SVGWidth    int      `json:"svg_width,omitempty"`
+	SVGTheme    string   `json:"svg_theme,omitempty"`
+	AIResponse  bool     `json:"ai_response,omitempty"`
 }
 
 // LunarReturnResponse represents the response from lunar return calculation
@@ -74,6 +75,7 @@ func (lrs *LunarReturnService) CalculateLunarReturn(req *LunarReturnRequest) (*L
 		LocalTime:   req.BirthTime,
 		City:        req.BirthCity,
 		HouseSystem: req.HouseSystem,
+		Bodies:      req.Bodies,
 		DrawChart:   false,
 	}
 
@@ -101,6 +103,7 @@ func (lrs *LunarReturnService) CalculateLunarReturn(req *LunarReturnRequest) (*L
 		LocalTime:   req.BirthTime, // Approximate - would need precise calculation
 		City:        returnCity,
 		HouseSystem: req.HouseSystem,
+		Bodies:      req.Bodies,
 		DrawChart:   req.DrawChart,
 		SVGWidth:    req.SVGWidth,
 		SVGTheme:    req.SVGTheme,
@@ -221,6 +224,9 @@ func (lrs *LunarReturnService) formatLunarReturnForLLM(response *LunarReturnResp
 	formatted += fmt.Sprintf("• Ascendant: %s %s\n", returnChart.Angles.Ascendant.Degree, returnChart.Angles.Ascendant.Sign)
 	formatted += fmt.Sprintf("• Midheaven: %s %s\n", returnChart.Angles.Midheaven.Degree, returnChart.Angles.Midheaven.Sign)
 
+	// Lunar return overview
+	formatted += formatChartOverview(returnChart.Overview)
+
 	// Major aspects involving the Moon
 	majorAspects := astro.FilterMajorAspects(returnChart.Aspects)
 	moonAspects := lrs.filterMoonAspects(majorAspects)
@@ -300,3 +306,16 @@ func (lrs *LunarReturnService) getMoonSignKeyword(sign string) string {
 	}
 	return "emotional growth and development"
 }
+
+// ValidateLunarReturnRequest validates a lunar return request
+func (lrs *LunarReturnService) ValidateLunarReturnRequest(req *LunarReturnRequest) error {
+	if req.HouseSystem != "" && !astro.IsValidHouseSystem(req.HouseSystem) {
+		return fmt.Errorf("invalid house system: %s", req.HouseSystem)
+	}
+
+	if _, err := astro.ParseBodies(req.Bodies); err != nil {
+		return err
+	}
+
+	return nil
+}
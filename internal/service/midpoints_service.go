@@ -0,0 +1,212 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"fmt"
+)
+
+// MidpointsService handles midpoint and dial calculations (Uranian astrology / Cosmobiology)
+type MidpointsService struct {
+	ephemeris          *astro.Ephemeris
+	planetCalculator   *astro.PlanetCalculator
+	houseCalculator    *astro.HouseCalculator
+	midpointCalculator *astro.MidpointCalculator
+	chartDrawer        *astro.ChartDrawer
+	logger             *logging.Logger
+}
+
+// NewMidpointsService creates a new midpoints service
+func NewMidpointsService(logger *logging.Logger) *MidpointsService {
+	ephemeris, err := astro.NewEphemeris(logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to initialize ephemeris for midpoints service")
+		return nil
+	}
+
+	return &MidpointsService{
+		ephemeris:          ephemeris,
+		planetCalculator:   astro.NewPlanetCalculator(ephemeris),
+		houseCalculator:    astro.NewHouseCalculator(ephemeris),
+		midpointCalculator: astro.NewMidpointCalculator(),
+		chartDrawer:        astro.NewChartDrawer(),
+		logger:             logger,
+	}
+}
+
+// MidpointsRequest represents a request for midpoint trees and planetary pictures
+type MidpointsRequest struct {
+	Day        int     `json:"day" binding:"required,min=1,max=31"`
+	Month      int     `json:"month" binding:"required,min=1,max=12"`
+	Year       int     `json:"year" binding:"required"`
+	LocalTime  string  `json:"local_time" binding:"required"` // HH:MM:SS format
+	City       string  `json:"city" binding:"required"`
+	Dial       float64 `json:"dial,omitempty"`        // 90, 45, 22.5 or 360 (defaults to 90)
+	Orb        float64 `json:"orb,omitempty"`         // dial orb in degrees (defaults to 1.5)
+	DrawChart  bool    `json:"draw_chart,omitempty"`  // whether to generate the dial SVG
+	SVGWidth   int     `json:"svg_width,omitempty"`   // width of SVG chart (defaults to 600)
+	SVGTheme   string  `json:"svg_theme,omitempty"`   // theme for SVG chart ("light", "dark", "mono")
+	AIResponse bool    `json:"ai_response,omitempty"` // whether to format response for LLM
+}
+
+// MidpointsResponse represents the response from a midpoint calculation
+type MidpointsResponse struct {
+	BirthInfo domain.BirthInfo `json:"birth_info"`
+	*domain.MidpointAnalysis
+	ChartDraw           string   `json:"chart_draw,omitempty"` // Dial SVG
+	Warnings            []string `json:"warnings,omitempty"`   // Fallbacks applied during the calculation
+	AIFormattedResponse *string  `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculateMidpoints calculates midpoints, midpoint trees and planetary pictures for a birth chart
+func (ms *MidpointsService) CalculateMidpoints(req *MidpointsRequest) (*MidpointsResponse, error) {
+	ms.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Float64("dial", req.Dial).
+		Float64("orb", req.Orb).
+		Msg("🔮 Starting midpoints calculation")
+
+	// Set defaults
+	if req.Dial == 0 {
+		req.Dial = domain.Dial90
+	}
+	if req.Orb <= 0 {
+		req.Orb = astro.DefaultMidpointOrb
+	}
+	if req.SVGWidth <= 0 && req.DrawChart {
+		req.SVGWidth = 600
+	}
+
+	geocodingService := astro.GetGeocodingService()
+	if geocodingService == nil {
+		return nil, fmt.Errorf("geocoding service not available")
+	}
+
+	location, err := geocodingService.GetCityInfo(req.City)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location for %s: %w", req.City, err)
+	}
+
+	timeInfo, err := domain.ParseTime(req.Year, req.Month, req.Day, req.LocalTime, location.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	_, housesData, err := ms.houseCalculator.CalculateHousesWithFallback(
+		timeInfo, location, domain.HousePlacidus, domain.HousePorphyrius)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate angles: %w", err)
+	}
+
+	planets, err := ms.planetCalculator.CalculateAllPlanets(timeInfo, housesData.Cusps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate planets: %w", err)
+	}
+
+	response := &MidpointsResponse{
+		BirthInfo: domain.BirthInfo{
+			Date:     timeInfo.FormatDateForDisplay(),
+			Time:     timeInfo.FormatTimeOnly(),
+			Location: *location,
+		},
+		MidpointAnalysis: ms.midpointCalculator.CalculateMidpointAnalysis(
+			planets, housesData.Ascendant, housesData.Midheaven, req.Dial, req.Orb),
+	}
+	if warning := astro.GetPolarFallbackWarning(domain.HousePlacidus, housesData, location.Latitude); warning != "" {
+		response.Warnings = append(response.Warnings, warning)
+	}
+
+	// Generate dial SVG if requested
+	if req.DrawChart {
+		theme := ms.chartDrawer.GetThemeFromString(req.SVGTheme)
+		svg, err := ms.chartDrawer.GenerateDialChart(
+			planets, housesData.Ascendant, housesData.Midheaven, req.Dial, req.SVGWidth, theme)
+		if err != nil {
+			ms.logger.Error().
+				Err(err).
+				Msg("Failed to generate dial SVG")
+			// Don't fail the entire request if SVG generation fails
+		} else {
+			response.ChartDraw = svg
+		}
+	}
+
+	ms.logger.Info().
+		Str("endpoint", "midpoints").
+		Int("midpoints_calculated", len(response.Midpoints)).
+		Int("pictures_found", len(response.Pictures)).
+		Msg("✨ Midpoints calculation completed successfully")
+
+	return response, nil
+}
+
+// GetMidpointsFormatted returns formatted midpoint trees for LLM consumption
+func (ms *MidpointsService) GetMidpointsFormatted(req *MidpointsRequest) (string, error) {
+	response, err := ms.CalculateMidpoints(req)
+	if err != nil {
+		return "", err
+	}
+
+	return ms.formatMidpointsForLLM(response), nil
+}
+
+// formatMidpointsForLLM formats midpoint trees and planetary pictures for LLM consumption
+func (ms *MidpointsService) formatMidpointsForLLM(response *MidpointsResponse) string {
+	formatted := "MIDPOINT ANALYSIS\n"
+	formatted += fmt.Sprintf("Birth Date: %s at %s\n", response.BirthInfo.Date, response.BirthInfo.Time)
+	formatted += fmt.Sprintf("Birth Location: %s\n", response.BirthInfo.Location.GetDisplayName())
+	formatted += fmt.Sprintf("Dial: %g° (orb %.1f°)\n", response.Dial, response.Orb)
+	for _, warning := range response.Warnings {
+		formatted += fmt.Sprintf("Warning: %s\n", warning)
+	}
+	formatted += "\n"
+
+	formatted += "MIDPOINT TREES:\n"
+	for _, tree := range response.Trees {
+		if len(tree.Midpoints) == 0 {
+			continue
+		}
+		formatted += fmt.Sprintf("• %s =", tree.Point)
+		for i, contact := range tree.Midpoints {
+			if i > 0 {
+				formatted += ","
+			}
+			formatted += fmt.Sprintf(" %s (%.2f°)", contact.Midpoint, contact.Orb)
+		}
+		formatted += "\n"
+	}
+
+	if len(response.Pictures) > 0 {
+		formatted += "\nPLANETARY PICTURES:\n"
+		for _, picture := range response.Pictures {
+			formatted += fmt.Sprintf("• %s (orb %.2f°)\n", picture.Formula, picture.Orb)
+		}
+	}
+
+	return formatted
+}
+
+// ValidateMidpointsRequest validates a midpoints request
+func (ms *MidpointsService) ValidateMidpointsRequest(req *MidpointsRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.City == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	if req.Dial != 0 && !domain.IsValidDial(req.Dial) {
+		return fmt.Errorf("invalid dial: %g (use 360, 90, 45 or 22.5)", req.Dial)
+	}
+
+	if req.Orb > 5 {
+		return fmt.Errorf("orb must be at most 5 degrees")
+	}
+
+	return nil
+}
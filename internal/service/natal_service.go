@@ -6,16 +6,21 @@ import (
 	"astroeph-api/internal/logging"
 	"astroeph-api/pkg/chart"
 	"fmt"
+	"strings"
 )
 
 // NatalService handles natal chart calculations
 type NatalService struct {
-	ephemeris        *astro.Ephemeris
-	planetCalculator *astro.PlanetCalculator
-	houseCalculator  *astro.HouseCalculator
-	aspectCalculator *astro.AspectCalculator
-	chartDrawer      *astro.ChartDrawer
-	logger           *logging.Logger
+	ephemeris             *astro.Ephemeris
+	planetCalculator      *astro.PlanetCalculator
+	houseCalculator       *astro.HouseCalculator
+	lotCalculator         *astro.LotCalculator
+	dignityCalculator     *astro.DignityCalculator
+	declinationCalculator *astro.DeclinationCalculator
+	antisciaCalculator    *astro.AntisciaCalculator
+	overviewCalculator    *astro.OverviewCalculator
+	chartDrawer           *astro.ChartDrawer
+	logger                *logging.Logger
 }
 
 // NewNatalService creates a new natal chart service
@@ -28,31 +33,58 @@ func NewNatalService(logger *logging.Logger) *NatalService {
 
 	planetCalc := astro.NewPlanetCalculator(ephemeris)
 	houseCalc := astro.NewHouseCalculator(ephemeris)
-	aspectCalc := astro.NewAspectCalculator()
+	lotCalc := astro.NewLotCalculator(ephemeris)
 	chartDrawer := astro.NewChartDrawer()
 
 	return &NatalService{
-		ephemeris:        ephemeris,
-		planetCalculator: planetCalc,
-		houseCalculator:  houseCalc,
-		aspectCalculator: aspectCalc,
-		chartDrawer:      chartDrawer,
-		logger:           logger,
+		ephemeris:             ephemeris,
+		planetCalculator:      planetCalc,
+		houseCalculator:       houseCalc,
+		lotCalculator:         lotCalc,
+		dignityCalculator:     astro.NewDignityCalculator(ephemeris),
+		declinationCalculator: astro.NewDeclinationCalculator(),
+		antisciaCalculator:    astro.NewAntisciaCalculator(),
+		overviewCalculator:    astro.NewOverviewCalculator(),
+		chartDrawer:           chartDrawer,
+		logger:                logger,
 	}
 }
 
 // NatalChartRequest represents a request for natal chart calculation
 type NatalChartRequest struct {
-	Day         int    `json:"day" binding:"required,min=1,max=31"`
-	Month       int    `json:"month" binding:"required,min=1,max=12"`
-	Year        int    `json:"year" binding:"required"`
-	LocalTime   string `json:"local_time" binding:"required"` // HH:MM:SS format
-	City        string `json:"city" binding:"required"`
-	HouseSystem string `json:"house_system,omitempty"` // defaults to "Placidus"
-	DrawChart   bool   `json:"draw_chart,omitempty"`   // whether to generate SVG chart
-	SVGWidth    int    `json:"svg_width,omitempty"`    // width of SVG chart (defaults to 600)
-	SVGTheme    string `json:"svg_theme,omitempty"`    // theme for SVG chart ("light", "dark", "mono")
-	AIResponse  bool   `json:"ai_response,omitempty"`  // whether to format response for LLM
+	Day                    int                           `json:"day" binding:"required,min=1,max=31"`
+	Month                  int                           `json:"month" binding:"required,min=1,max=12"`
+	Year                   int                           `json:"year" binding:"required"`
+	LocalTime              string                        `json:"local_time" binding:"required"` // HH:MM:SS format
+	City                   string                        `json:"city" binding:"required"`
+	HouseSystem            string                        `json:"house_system,omitempty"`             // defaults to "Placidus"
+	PolarFallback          string                        `json:"polar_fallback,omitempty"`           // "Porphyrius" or "Whole Sign" when the house system fails inside the polar circles (defaults to "Porphyrius")
+	Bodies                 []string                      `json:"bodies,omitempty"`                   // optional extra bodies, e.g. ["ceres", "lilith", "asteroid:433"]
+	IncludeCusps           bool                          `json:"include_cusps,omitempty"`            // whether to aspect the intermediate house cusps
+	IncludeSensitivePoints bool                          `json:"include_sensitive_points,omitempty"` // whether to add the Vertex, East Point, co-ascendants and polar ascendant to the angles, aspects and SVG
+	GauquelinSectors       bool                          `json:"gauquelin_sectors,omitempty"`        // whether to give the Gauquelin sector of each planet
+	IncludeLots            bool                          `json:"include_lots,omitempty"`             // whether to calculate the seven Hermetic lots
+	CustomLots             []domain.LotDefinition        `json:"custom_lots,omitempty"`              // user-defined lots, e.g. {"name": "Marriage", "formula": "Asc + Venus - Saturn"}
+	DeclinationOrb         float64                       `json:"declination_orb,omitempty"`          // orb for parallels and contraparallels (defaults to 1)
+	AntisciaOrb            float64                       `json:"antiscia_orb,omitempty"`             // orb for antiscia and contra-antiscia contacts (defaults to 1)
+	OrbProfile             string                        `json:"orb_profile,omitempty"`              // "standard", "tight", "wide" or "moiety" (defaults to "standard")
+	AspectOrbs             map[domain.AspectType]float64 `json:"aspect_orbs,omitempty"`              // base orb overrides per aspect, e.g. {"trine": 6}; 0 disables the aspect
+	PlanetOrbs             map[string]float64            `json:"planet_orbs,omitempty"`              // orb adjustments per planet, or full planetary orbs with "moiety"
+	MinorAspects           bool                          `json:"minor_aspects,omitempty"`            // whether to include semisextiles, semisquares and sesquisquares
+	ExtendedAspects        bool                          `json:"extended_aspects,omitempty"`         // whether to include the quintile, septile, novile and decile series
+	PointOrbs              map[domain.PlanetType]float64 `json:"point_orbs,omitempty"`               // maximum orb per point type: "angle", "house_cusp", "lot", "lunar"
+	CustomAspects          []domain.CustomAspect         `json:"custom_aspects,omitempty"`           // extra aspects, e.g. {"name": "vigintile", "angle": 18, "orb": 1, "symbol": "V", "color": "#8e44ad"}
+	ExactWindowDays        float64                       `json:"exact_window_days,omitempty"`        // days around birth within which the date of perfection is given (defaults to 30)
+	IncludeDignities       bool                          `json:"include_dignities,omitempty"`        // whether to score essential dignities and find the almutens
+	IncludeDispositors     bool                          `json:"include_dispositors,omitempty"`      // whether to follow dispositor chains and find mutual receptions
+	Rulership              string                        `json:"rulership,omitempty"`                // "traditional" or "modern" sign rulers (defaults to "traditional")
+	Triplicities           string                        `json:"triplicities,omitempty"`             // "dorothean" or "lilly" triplicity rulers (defaults to "dorothean")
+	Terms                  string                        `json:"terms,omitempty"`                    // "egyptian" or "ptolemaic" terms (defaults to "egyptian")
+	PointWeights           map[string]float64            `json:"point_weights,omitempty"`            // weights of the points in the balances, e.g. {"Sun": 4, "Ascendant": 4}; 0 leaves a point out
+	DrawChart              bool                          `json:"draw_chart,omitempty"`               // whether to generate SVG chart
+	SVGWidth               int                           `json:"svg_width,omitempty"`                // width of SVG chart (defaults to 600)
+	SVGTheme               string                        `json:"svg_theme,omitempty"`                // theme for SVG chart ("light", "dark", "mono")
+	AIResponse             bool                          `json:"ai_response,omitempty"`              // whether to format response for LLM
 }
 
 // NatalChartResponse represents the response from natal chart calculation
@@ -69,6 +101,12 @@ func (ns *NatalService) CalculateNatalChart(req *NatalChartRequest) (*NatalChart
 		Int("month", req.Month).
 		Int("day", req.Day).
 		Str("house_system", req.HouseSystem).
+		Strs("bodies", req.Bodies).
+		Bool("include_lots", req.IncludeLots).
+		Bool("include_dignities", req.IncludeDignities).
+		Bool("include_dispositors", req.IncludeDispositors).
+		Int("custom_lots", len(req.CustomLots)).
+		Str("orb_profile", req.OrbProfile).
 		Bool("draw_chart", req.DrawChart).
 		Msg("🔮 Starting natal chart calculation")
 
@@ -76,9 +114,27 @@ func (ns *NatalService) CalculateNatalChart(req *NatalChartRequest) (*NatalChart
 	if req.HouseSystem == "" {
 		req.HouseSystem = "Placidus"
 	}
+	if req.PolarFallback == "" {
+		req.PolarFallback = string(domain.HousePorphyrius)
+	}
 	if req.SVGWidth <= 0 && req.DrawChart {
 		req.SVGWidth = 600
 	}
+	if req.DeclinationOrb <= 0 {
+		req.DeclinationOrb = astro.DefaultDeclinationOrb
+	}
+	if req.AntisciaOrb <= 0 {
+		req.AntisciaOrb = astro.DefaultAntisciaOrb
+	}
+	if req.ExactWindowDays <= 0 {
+		req.ExactWindowDays = astro.DefaultExactWindowDays
+	}
+
+	// Orbs and enabled aspects come from the requested profile and overrides
+	aspectCalculator, err := astro.NewAspectCalculatorWithSettings(req.aspectSettings())
+	if err != nil {
+		return nil, err
+	}
 
 	// Get location information
 	geocodingService := astro.GetGeocodingService()
@@ -112,10 +168,20 @@ func (ns *NatalService) CalculateNatalChart(req *NatalChartRequest) (*NatalChart
 
 	// Calculate houses first (needed for planet house assignments)
 	houseSystem := domain.HouseSystem(req.HouseSystem)
-	houses, err := ns.houseCalculator.CalculateHouses(timeInfo, location, houseSystem)
+	houses, housesData, err := ns.houseCalculator.CalculateHousesWithFallback(
+		timeInfo, location, houseSystem, domain.HouseSystem(req.PolarFallback))
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate houses: %w", err)
 	}
+	if warning := astro.GetPolarFallbackWarning(houseSystem, housesData, location.Latitude); warning != "" {
+		natalChart.HouseSystem = string(housesData.HouseSystem)
+		natalChart.Warnings = append(natalChart.Warnings, warning)
+		ns.logger.Warn().
+			Str("house_system", string(houseSystem)).
+			Str("fallback", string(housesData.HouseSystem)).
+			Float64("latitude", location.Latitude).
+			Msg("House system fallback inside the polar circle")
+	}
 
 	// Add houses to chart
 	for _, house := range houses {
@@ -129,29 +195,157 @@ func (ns *NatalService) CalculateNatalChart(req *NatalChartRequest) (*NatalChart
 	}
 
 	// Calculate planets
-	planets, err := ns.planetCalculator.CalculateAllPlanets(timeInfo, houseCusps)
+	planets, err := ns.planetCalculator.CalculatePlanetsWithBodies(timeInfo, houseCusps, req.Bodies)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate planets: %w", err)
 	}
 
+	// Place the planets in houses by their position on the sphere, latitude included
+	positionWarnings, err := ns.houseCalculator.CalculateHousePositions(
+		planets, timeInfo, location, housesData, req.GauquelinSectors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate house positions: %w", err)
+	}
+	natalChart.Warnings = append(natalChart.Warnings, positionWarnings...)
+
+	// Flag out-of-bounds planets against the Sun's greatest declination for the date
+	obliquity, err := ns.ephemeris.GetObliquity(ns.ephemeris.GetJulianDay(timeInfo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate obliquity: %w", err)
+	}
+	ns.declinationCalculator.MarkOutOfBounds(planets, obliquity)
+
 	// Add planets to chart
 	for _, planet := range planets {
 		natalChart.AddPlanet(planet)
 	}
 
-	// Calculate aspects
-	aspects := ns.aspectCalculator.CalculateAspects(planets)
-	for _, aspect := range aspects {
-		natalChart.AddAspect(aspect)
-	}
-
 	// Set chart angles (Ascendant and Midheaven)
 	if len(houseCusps) >= 10 {
 		ascendant := houseCusps[0] // 1st house cusp
 		midheaven := houseCusps[9] // 10th house cusp
 		natalChart.SetAngles(ascendant, midheaven)
+		natalChart.SetAngleSpeeds(houses[0].CuspSpeed, houses[9].CuspSpeed)
+	}
+
+	// Set the Vertex, East Point, co-ascendants and polar ascendant
+	if req.IncludeSensitivePoints {
+		natalChart.SetSensitivePoints(
+			housesData.ARMC,
+			domain.NewChartAngle(housesData.Vertex, housesData.VertexSpeed),
+			domain.NewChartAngle(housesData.EquatorialAsc, housesData.EquatorialAscSpeed),
+			domain.NewChartAngle(housesData.CoAscendant1, housesData.CoAscendant1Speed),
+			domain.NewChartAngle(housesData.CoAscendant2, housesData.CoAscendant2Speed),
+			domain.NewChartAngle(housesData.PolarAsc, housesData.PolarAscSpeed),
+		)
+	}
+
+	// Calculate lots (Arabic parts)
+	if req.IncludeLots || len(req.CustomLots) > 0 {
+		lots, err := ns.lotCalculator.CalculateLots(
+			planets,
+			houses,
+			natalChart.Angles,
+			req.IncludeLots,
+			req.CustomLots,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate lots: %w", err)
+		}
+		for _, lot := range lots {
+			natalChart.AddLot(lot)
+		}
+	}
+
+	// Set house rulers for the requested rulership scheme
+	dignitySettings := req.dignitySettings()
+	if err := dignitySettings.Validate(); err != nil {
+		return nil, err
+	}
+	for i := range natalChart.Houses {
+		natalChart.Houses[i].Ruler = domain.GetSignRuler(natalChart.Houses[i].Sign, dignitySettings.Rulership)
+	}
+
+	// Calculate essential dignities and almutens
+	if req.IncludeDignities {
+		isNight := astro.IsNightChart(planets, natalChart.Angles.Ascendant.Value)
+		dignities, err := ns.dignityCalculator.CalculateDignities(
+			timeInfo,
+			planets,
+			natalChart.Houses,
+			natalChart.Angles.Ascendant.Value,
+			isNight,
+			dignitySettings,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate dignities: %w", err)
+		}
+
+		// Accidental condition and overall strength, in chart order
+		essentials := make(map[string]domain.EssentialDignity)
+		for _, essential := range dignities.Planets {
+			essentials[essential.Planet] = essential
+		}
+		accidentals := ns.planetCalculator.CalculateAccidentalDignities(planets, isNight)
+		strengths := ns.planetCalculator.GetPlanetaryStrengths(planets, essentials, accidentals)
+		for _, planet := range planets {
+			if accidental, exists := accidentals[planet.Name]; exists {
+				dignities.Accidental = append(dignities.Accidental, accidental)
+				dignities.Strengths = append(dignities.Strengths, strengths[planet.Name])
+			}
+		}
+		natalChart.Dignities = dignities
 	}
 
+	// Follow dispositor chains
+	if req.IncludeDispositors {
+		dispositors := domain.NewDispositorReport(planets, dignitySettings.Rulership)
+		if req.DrawChart {
+			svg, err := ns.chartDrawer.GenerateDispositorChart(&dispositors, req.SVGWidth, ns.parseTheme(req.SVGTheme))
+			if err != nil {
+				ns.logger.Error().
+					Err(err).
+					Msg("Failed to generate dispositor SVG")
+			} else {
+				dispositors.ChartDraw = svg
+			}
+		}
+		natalChart.Dispositors = &dispositors
+	}
+
+	// Chart shape, hemispheres, balances and chart ruler
+	natalChart.Overview = ns.overviewCalculator.CalculateOverview(
+		planets,
+		natalChart.Angles.Ascendant.Value,
+		natalChart.Angles.Midheaven.Value,
+		req.PointWeights,
+		dignitySettings,
+		astro.IsNightChart(planets, natalChart.Angles.Ascendant.Value),
+	)
+
+	// Calculate aspects, angles, sensitive points, lots and cusps are aspected to planets only
+	points := append(natalChart.GetAnglePoints(), natalChart.GetSensitivePoints()...)
+	points = append(points, natalChart.GetLotPoints()...)
+	if req.IncludeCusps {
+		points = append(points, natalChart.GetHouseCuspPoints()...)
+	}
+	aspects := aspectCalculator.CalculateAspectsWithPoints(planets, points)
+	for _, aspect := range aspects {
+		aspect.SetExactDate(timeInfo.UTCTime, req.ExactWindowDays)
+		natalChart.AddAspect(aspect)
+	}
+
+	// Detect aspect patterns between planets
+	natalChart.Patterns = aspectCalculator.CalculateAspectPatterns(natalChart.Aspects, planets)
+
+	// Calculate parallels and contraparallels
+	natalChart.DeclinationAspects = ns.declinationCalculator.CalculateDeclinationAspects(planets, req.DeclinationOrb)
+
+	// Calculate antiscia and contra-antiscia
+	natalChart.Antiscia = ns.antisciaCalculator.CalculateAntiscia(
+		planets, natalChart.Angles.Ascendant.Value, natalChart.Angles.Midheaven.Value)
+	natalChart.AntisciaAspects = ns.antisciaCalculator.CalculateAntisciaAspects(natalChart.Antiscia, req.AntisciaOrb)
+
 	// Generate SVG chart if requested
 	if req.DrawChart {
 		theme := ns.parseTheme(req.SVGTheme)
@@ -171,11 +365,34 @@ func (ns *NatalService) CalculateNatalChart(req *NatalChartRequest) (*NatalChart
 		Int("planets_calculated", len(natalChart.Planets)).
 		Int("houses_calculated", len(natalChart.Houses)).
 		Int("aspects_found", len(natalChart.Aspects)).
+		Int("lots_calculated", len(natalChart.Lots)).
 		Msg("✨ Natal chart calculation completed successfully")
 
 	return &NatalChartResponse{Chart: natalChart}, nil
 }
 
+// aspectSettings returns the aspect orb settings of the request
+func (req *NatalChartRequest) aspectSettings() astro.AspectSettings {
+	return astro.AspectSettings{
+		Profile:         req.OrbProfile,
+		AspectOrbs:      req.AspectOrbs,
+		PlanetOrbs:      req.PlanetOrbs,
+		MinorAspects:    req.MinorAspects,
+		ExtendedAspects: req.ExtendedAspects,
+		CustomAspects:   req.CustomAspects,
+		PointOrbs:       req.PointOrbs,
+	}
+}
+
+// dignitySettings returns the essential dignity tables of the request
+func (req *NatalChartRequest) dignitySettings() domain.DignitySettings {
+	return domain.DignitySettings{
+		Rulership:    req.Rulership,
+		Triplicities: req.Triplicities,
+		Terms:        req.Terms,
+	}
+}
+
 // parseTheme converts theme string to chart theme type
 func (ns *NatalService) parseTheme(themeStr string) *chart.ThemeType {
 	return ns.chartDrawer.GetThemeFromString(themeStr)
@@ -197,19 +414,69 @@ func (ns *NatalService) formatChartForLLM(chart *domain.Chart) string {
 	formatted += fmt.Sprintf("Birth Date: %s at %s\n", chart.BirthInfo.Date, chart.BirthInfo.Time)
 	formatted += fmt.Sprintf("Birth Location: %s\n", chart.BirthInfo.Location.GetDisplayName())
 	formatted += fmt.Sprintf("Coordinates: %s\n", chart.BirthInfo.Location.FormatCoordinates())
-	formatted += fmt.Sprintf("House System: %s\n\n", chart.HouseSystem)
+	formatted += fmt.Sprintf("House System: %s\n", chart.HouseSystem)
+	for _, warning := range chart.Warnings {
+		formatted += fmt.Sprintf("Warning: %s\n", warning)
+	}
+	formatted += "\n"
 
 	// Planetary Positions
 	formatted += "PLANETARY POSITIONS:\n"
 	for _, planet := range chart.Planets {
-		formatted += fmt.Sprintf("• %s: %s %s (House %d)\n",
-			planet.Name, planet.Degree, planet.Sign, planet.House)
+		formatted += fmt.Sprintf("• %s: %s %s (House %d", planet.Name, planet.Degree, planet.Sign, planet.House)
+		if planet.HousePosition > 0 {
+			formatted += fmt.Sprintf(", position %.2f", planet.HousePosition)
+		}
+		if planet.GauquelinSector > 0 {
+			formatted += fmt.Sprintf(", Gauquelin sector %d", int(planet.GauquelinSector))
+		}
+		formatted += ")\n"
 	}
 
 	// Chart Angles
 	formatted += "\nCHART ANGLES:\n"
 	formatted += fmt.Sprintf("• Ascendant: %s %s\n", chart.Angles.Ascendant.Degree, chart.Angles.Ascendant.Sign)
 	formatted += fmt.Sprintf("• Midheaven: %s %s\n", chart.Angles.Midheaven.Degree, chart.Angles.Midheaven.Sign)
+	for _, point := range []struct {
+		name  string
+		angle *domain.ChartAngle
+	}{
+		{domain.PointVertex, chart.Angles.Vertex},
+		{domain.PointAntiVertex, chart.Angles.AntiVertex},
+		{domain.PointEastPoint, chart.Angles.EastPoint},
+		{domain.PointCoAscendantKoch, chart.Angles.CoAscendantKoch},
+		{domain.PointCoAscendantMunkasey, chart.Angles.CoAscendantMunkasey},
+		{domain.PointPolarAscendant, chart.Angles.PolarAscendant},
+	} {
+		if point.angle != nil {
+			formatted += fmt.Sprintf("• %s: %s %s\n", point.name, point.angle.Degree, point.angle.Sign)
+		}
+	}
+
+	// Overview
+	formatted += formatChartOverview(chart.Overview)
+
+	// Aspect Patterns
+	if len(chart.Patterns) > 0 {
+		formatted += "\nASPECT PATTERNS:\n"
+		for _, pattern := range chart.Patterns {
+			formatted += ns.formatPattern(pattern)
+		}
+	}
+
+	// Out-of-bounds planets
+	var outOfBounds []domain.Planet
+	for _, planet := range chart.Planets {
+		if planet.OutOfBounds {
+			outOfBounds = append(outOfBounds, planet)
+		}
+	}
+	if len(outOfBounds) > 0 {
+		formatted += "\nOUT OF BOUNDS:\n"
+		for _, planet := range outOfBounds {
+			formatted += fmt.Sprintf("• %s is out of bounds (declination %.2f°)\n", planet.Name, planet.Declination)
+		}
+	}
 
 	// House Cusps
 	formatted += "\nHOUSE CUSPS:\n"
@@ -217,27 +484,101 @@ func (ns *NatalService) formatChartForLLM(chart *domain.Chart) string {
 		formatted += fmt.Sprintf("• House %d: %s %s\n", house.Number, house.Cusp, house.Sign)
 	}
 
+	// Essential Dignities
+	if chart.Dignities != nil {
+		formatted += fmt.Sprintf("\nESSENTIAL DIGNITIES (%s rulership, %s triplicities, %s terms):\n",
+			chart.Dignities.Settings.Rulership, chart.Dignities.Settings.Triplicities, chart.Dignities.Settings.Terms)
+		for _, dignity := range chart.Dignities.Planets {
+			formatted += fmt.Sprintf("• %s in %s: %+d%s\n", dignity.Planet, dignity.Sign, dignity.Score, ns.formatDignityList(dignity))
+		}
+		for _, accidental := range chart.Dignities.Accidental {
+			formatted += fmt.Sprintf("• %s accidental: %+d (%s)\n", accidental.Planet, accidental.Score, ns.formatAccidentalConditions(accidental))
+		}
+		for _, strength := range chart.Dignities.Strengths {
+			formatted += fmt.Sprintf("• %s strength: %+d (essential %+d, accidental %+d)\n",
+				strength.Planet, strength.OverallScore, strength.DignityScore, strength.AccidentalScore)
+		}
+		if chart.Dignities.AlmutenFiguris != "" {
+			formatted += fmt.Sprintf("• Almuten figuris: %s\n", chart.Dignities.AlmutenFiguris)
+		}
+		for _, house := range chart.Houses {
+			formatted += fmt.Sprintf("• House %d: ruler %s, almuten %s\n", house.Number, house.Ruler, house.Almuten)
+		}
+	}
+
+	// Dispositors
+	if chart.Dispositors != nil {
+		formatted += fmt.Sprintf("\nDISPOSITORS (%s rulership):\n", chart.Dispositors.Rulership)
+		for _, dispositorChain := range chart.Dispositors.Chains {
+			formatted += fmt.Sprintf("• %s\n", strings.Join(dispositorChain.Chain, " → "))
+		}
+		for _, loop := range chart.Dispositors.Loops {
+			if len(loop) == 1 {
+				formatted += fmt.Sprintf("• %s is in its own domicile\n", loop[0])
+			} else {
+				formatted += fmt.Sprintf("• Loop: %s\n", strings.Join(loop, " → "))
+			}
+		}
+		if chart.Dispositors.FinalDispositor != "" {
+			formatted += fmt.Sprintf("• Final dispositor: %s\n", chart.Dispositors.FinalDispositor)
+		}
+		for _, reception := range chart.Dispositors.MutualReceptions {
+			formatted += fmt.Sprintf("• %s and %s in mutual reception by %s\n",
+				reception.Planet1, reception.Planet2, reception.Type)
+		}
+	}
+
+	// Lots
+	if len(chart.Lots) > 0 {
+		formatted += "\nLOTS:\n"
+		for _, lot := range chart.Lots {
+			formatted += fmt.Sprintf("• %s: %s %s (House %d) = %s\n",
+				lot.Name, lot.Degree, lot.Sign, lot.House, lot.Formula)
+		}
+	}
+
 	// Major Aspects
 	if len(chart.Aspects) > 0 {
 		formatted += "\nMAJOR ASPECTS:\n"
 		for _, aspect := range chart.Aspects {
 			if aspect.IsMajorAspect() {
-				formatted += fmt.Sprintf("• %s %s %s - %.1f° orb\n",
-					aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+				formatted += fmt.Sprintf("• %s %s %s - %.1f° orb, %s",
+					aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb, ns.formatAspectMotion(aspect))
+				if aspect.ExactDate != nil {
+					formatted += fmt.Sprintf(", exact %s", aspect.ExactDate.Format("2006-01-02 15:04 UTC"))
+				}
+				formatted += "\n"
 			}
 		}
 	}
 
+	// Declination Aspects
+	if len(chart.DeclinationAspects) > 0 {
+		formatted += "\nDECLINATION ASPECTS:\n"
+		for _, aspect := range chart.DeclinationAspects {
+			formatted += fmt.Sprintf("• %s %s %s - %.2f° orb\n",
+				aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+		}
+	}
+
+	// Antiscia
+	if len(chart.AntisciaAspects) > 0 {
+		formatted += "\nANTISCIA:\n"
+		for _, aspect := range chart.AntisciaAspects {
+			formatted += fmt.Sprintf("• %s %s %s - %.2f° orb\n",
+				aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+		}
+	}
+
 	// Summary
 	formatted += fmt.Sprintf("\nASTROLOGICAL SUMMARY:\n")
 	formatted += fmt.Sprintf("This natal chart shows %d planetary positions across %d houses, with %d major aspects. ",
 		len(chart.Planets), len(chart.Houses), len(astro.FilterMajorAspects(chart.Aspects)))
 
 	// Element emphasis
-	elementCounts := ns.getElementCounts(chart.Planets)
-	dominantElement, maxCount := ns.getDominantElement(elementCounts)
-	if maxCount > 1 {
-		formatted += fmt.Sprintf("There is a notable emphasis in %s with %d planetary placements. ", dominantElement, maxCount)
+	if chart.Overview != nil {
+		formatted += fmt.Sprintf("The chart takes a %s shape with a notable emphasis in %s and %s signs. ",
+			chart.Overview.Shape, chart.Overview.Elements.Dominant, chart.Overview.Modalities.Dominant)
 	}
 
 	formatted += "This chart provides a comprehensive astrological foundation for interpretation."
@@ -245,28 +586,86 @@ func (ns *NatalService) formatChartForLLM(chart *domain.Chart) string {
 	return formatted
 }
 
-// getElementCounts counts planets by element
-func (ns *NatalService) getElementCounts(planets []domain.Planet) map[string]int {
-	counts := make(map[string]int)
-	for _, planet := range planets {
-		counts[planet.Element]++
+// formatPattern formats a single aspect pattern line
+func (ns *NatalService) formatPattern(pattern domain.AspectPattern) string {
+	line := fmt.Sprintf("• %s: %s", pattern.Type, strings.Join(pattern.Planets, ", "))
+	if pattern.Apex != "" {
+		line += fmt.Sprintf(" (apex %s)", pattern.Apex)
+	}
+	if pattern.Sign != "" {
+		line += fmt.Sprintf(" in %s", pattern.Sign)
+	}
+	if pattern.House > 0 {
+		line += fmt.Sprintf(" in house %d", pattern.House)
 	}
-	return counts
+	if pattern.Element != "" {
+		line += fmt.Sprintf(" [%s]", pattern.Element)
+	}
+	return line + "\n"
 }
 
-// getDominantElement finds the element with the most planets
-func (ns *NatalService) getDominantElement(elementCounts map[string]int) (string, int) {
-	maxCount := 0
-	dominantElement := ""
+// formatChartOverview formats the shape, hemispheres, balances and chart ruler of a chart;
+// it is shared by the natal, return and composite formatters
+func formatChartOverview(overview *domain.ChartOverview) string {
+	if overview == nil {
+		return ""
+	}
+
+	formatted := "\nCHART OVERVIEW:\n"
+	if overview.Shape != "" {
+		formatted += fmt.Sprintf("• Shape: %s", overview.Shape)
+		if overview.ShapeLeader != "" {
+			role := "leading planet"
+			if overview.Shape == domain.ShapeBucket {
+				role = "handle"
+			}
+			formatted += fmt.Sprintf(" (%s %s)", role, overview.ShapeLeader)
+		}
+		formatted += "\n"
+	}
+
+	hemispheres := overview.Hemispheres
+	formatted += fmt.Sprintf("• Hemispheres: eastern %.0f, western %.0f, northern %.0f, southern %.0f",
+		hemispheres.Eastern, hemispheres.Western, hemispheres.Northern, hemispheres.Southern)
+	if len(hemispheres.Emphasis) > 0 {
+		formatted += fmt.Sprintf(" - emphasis %s", strings.Join(hemispheres.Emphasis, ", "))
+	}
+	formatted += fmt.Sprintf("\n• Quadrants: I %.0f, II %.0f, III %.0f, IV %.0f\n",
+		hemispheres.Quadrants[0], hemispheres.Quadrants[1], hemispheres.Quadrants[2], hemispheres.Quadrants[3])
+
+	for _, balance := range []struct {
+		name      string
+		balance   domain.Balance
+		qualities []string
+	}{
+		{"Elements", overview.Elements, []string{"fire", "earth", "air", "water"}},
+		{"Modalities", overview.Modalities, []string{"cardinal", "fixed", "mutable"}},
+		{"Polarities", overview.Polarities, []string{"positive", "negative"}},
+	} {
+		var scores []string
+		for _, quality := range balance.qualities {
+			scores = append(scores, fmt.Sprintf("%s %.0f", quality, balance.balance.Scores[quality]))
+		}
+		formatted += fmt.Sprintf("• %s: %s - dominant %s", balance.name, strings.Join(scores, ", "), balance.balance.Dominant)
+		if len(balance.balance.Missing) > 0 {
+			formatted += fmt.Sprintf(", missing %s", strings.Join(balance.balance.Missing, ", "))
+		}
+		formatted += "\n"
+	}
 
-	for element, count := range elementCounts {
-		if count > maxCount {
-			maxCount = count
-			dominantElement = element
+	if ruler := overview.ChartRuler; ruler != nil {
+		formatted += fmt.Sprintf("• Chart ruler: %s in %s (House %d), essential %+d",
+			ruler.Planet, ruler.Sign, ruler.House, ruler.Essential.Score)
+		if ruler.Accidental != nil {
+			formatted += fmt.Sprintf(", accidental %+d", ruler.Accidental.Score)
 		}
+		if ruler.IsRetrograde {
+			formatted += ", retrograde"
+		}
+		formatted += "\n"
 	}
 
-	return dominantElement, maxCount
+	return formatted
 }
 
 // GetSupportedHouseSystems returns available house systems
@@ -279,6 +678,63 @@ func (ns *NatalService) GetSupportedHouseSystems() []string {
 	return systemNames
 }
 
+// formatDignityList lists the dignities and debilities held by a planet
+func (ns *NatalService) formatDignityList(dignity domain.EssentialDignity) string {
+	var held []string
+	for _, d := range []struct {
+		name string
+		held bool
+	}{
+		{"domicile", dignity.Domicile},
+		{"exaltation", dignity.Exaltation},
+		{"triplicity", dignity.Triplicity},
+		{"term", dignity.Term},
+		{"face", dignity.Face},
+		{"detriment", dignity.Detriment},
+		{"fall", dignity.Fall},
+		{"peregrine", dignity.Peregrine},
+	} {
+		if d.held {
+			held = append(held, d.name)
+		}
+	}
+	return " (" + strings.Join(held, ", ") + ")"
+}
+
+// formatAccidentalConditions lists the accidental conditions of a planet
+func (ns *NatalService) formatAccidentalConditions(accidental domain.AccidentalDignity) string {
+	conditions := []string{accidental.Angularity, accidental.Motion}
+	if accidental.SolarCondition != "" && accidental.SolarCondition != domain.SolarFree {
+		conditions = append(conditions, strings.ReplaceAll(accidental.SolarCondition, "_", " "))
+	}
+	if accidental.SolarPhase != "" {
+		conditions = append(conditions, accidental.SolarPhase)
+	}
+	if accidental.InJoy {
+		conditions = append(conditions, "in joy")
+	}
+	if accidental.Hayz {
+		conditions = append(conditions, "hayz")
+	} else if accidental.Sect != "" && !accidental.InSect {
+		conditions = append(conditions, "out of sect")
+	}
+	return strings.Join(conditions, ", ")
+}
+
+// formatAspectMotion describes whether an aspect is applying or separating and how far it is from exact
+func (ns *NatalService) formatAspectMotion(aspect domain.Aspect) string {
+	switch {
+	case aspect.IsExact:
+		return "exact"
+	case aspect.DaysToExact == nil:
+		return "stationary"
+	case aspect.IsApplying:
+		return fmt.Sprintf("applying (%.1f days to exact)", *aspect.DaysToExact)
+	default:
+		return fmt.Sprintf("separating (%.1f days since exact)", -*aspect.DaysToExact)
+	}
+}
+
 // ValidateNatalChartRequest validates a natal chart request
 func (ns *NatalService) ValidateNatalChartRequest(req *NatalChartRequest) error {
 	if req.Day < 1 || req.Day > 31 {
@@ -305,5 +761,49 @@ func (ns *NatalService) ValidateNatalChartRequest(req *NatalChartRequest) error
 		return fmt.Errorf("invalid house system: %s", req.HouseSystem)
 	}
 
+	if req.PolarFallback != "" && !astro.IsValidPolarFallback(req.PolarFallback) {
+		return fmt.Errorf("polar_fallback must be Porphyrius or Whole Sign")
+	}
+
+	bodies, err := astro.ParseBodies(req.Bodies)
+	if err != nil {
+		return err
+	}
+
+	if req.DeclinationOrb > 3 {
+		return fmt.Errorf("declination_orb must be at most 3 degrees")
+	}
+
+	if req.AntisciaOrb > 5 {
+		return fmt.Errorf("antiscia_orb must be at most 5 degrees")
+	}
+
+	if req.ExactWindowDays > 3650 {
+		return fmt.Errorf("exact_window_days must be at most 3650")
+	}
+
+	if err := astro.ValidatePointWeights(req.PointWeights); err != nil {
+		return err
+	}
+
+	dignitySettings := req.dignitySettings()
+	if err := dignitySettings.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := astro.NewAspectCalculatorWithSettings(req.aspectSettings()); err != nil {
+		return err
+	}
+
+	for _, lot := range req.CustomLots {
+		if strings.TrimSpace(lot.Name) == "" {
+			return fmt.Errorf("custom lot name is required")
+		}
+	}
+
+	if err := astro.ValidateLotPoints(req.CustomLots, bodies.GetPlanetNames(ns.ephemeris), req.IncludeLots); err != nil {
+		return err
+	}
+
 	return nil
 }
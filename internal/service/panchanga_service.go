@@ -0,0 +1,163 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"fmt"
+)
+
+// PanchangaService handles daily panchanga calculations
+type PanchangaService struct {
+	ephemeris           *astro.Ephemeris
+	panchangaCalculator *astro.PanchangaCalculator
+	logger              *logging.Logger
+}
+
+// NewPanchangaService creates a new panchanga service
+func NewPanchangaService(logger *logging.Logger) *PanchangaService {
+	ephemeris, err := astro.NewEphemeris(logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to initialize ephemeris for panchanga service")
+		return nil
+	}
+
+	return &PanchangaService{
+		ephemeris:           ephemeris,
+		panchangaCalculator: astro.NewPanchangaCalculator(ephemeris),
+		logger:              logger,
+	}
+}
+
+// PanchangaRequest represents a request for a daily panchanga
+type PanchangaRequest struct {
+	Day        int    `json:"day" binding:"required,min=1,max=31"`
+	Month      int    `json:"month" binding:"required,min=1,max=12"`
+	Year       int    `json:"year" binding:"required"`
+	City       string `json:"city" binding:"required"`
+	Ayanamsa   string `json:"ayanamsa,omitempty"`    // defaults to "Lahiri"
+	AIResponse bool   `json:"ai_response,omitempty"` // whether to format response for LLM
+}
+
+// PanchangaResponse represents the response from a panchanga calculation
+type PanchangaResponse struct {
+	*domain.Panchanga
+	AIFormattedResponse *string `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculatePanchanga calculates the five limbs of the panchanga for a city and date
+func (ps *PanchangaService) CalculatePanchanga(req *PanchangaRequest) (*PanchangaResponse, error) {
+	ps.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Str("ayanamsa", req.Ayanamsa).
+		Msg("🔮 Starting panchanga calculation")
+
+	if req.Ayanamsa == "" {
+		req.Ayanamsa = "Lahiri"
+	}
+
+	geocodingService := astro.GetGeocodingService()
+	if geocodingService == nil {
+		return nil, fmt.Errorf("geocoding service not available")
+	}
+
+	location, err := geocodingService.GetCityInfo(req.City)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location for %s: %w", req.City, err)
+	}
+
+	// The panchanga day is searched from local midnight in the city's time zone
+	dayStart, err := domain.ParseTime(req.Year, req.Month, req.Day, "00:00:00", location.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	panchanga, err := ps.panchangaCalculator.CalculatePanchanga(dayStart, location, req.Ayanamsa)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate panchanga: %w", err)
+	}
+
+	ps.logger.Info().
+		Str("endpoint", "panchanga").
+		Str("vara", panchanga.Vara.Name).
+		Int("tithis_found", len(panchanga.Tithis)).
+		Int("nakshatras_found", len(panchanga.Nakshatras)).
+		Msg("✨ Panchanga calculation completed successfully")
+
+	return &PanchangaResponse{Panchanga: panchanga}, nil
+}
+
+// GetPanchangaFormatted returns a formatted panchanga for LLM consumption
+func (ps *PanchangaService) GetPanchangaFormatted(req *PanchangaRequest) (string, error) {
+	response, err := ps.CalculatePanchanga(req)
+	if err != nil {
+		return "", err
+	}
+
+	return ps.formatPanchangaForLLM(response.Panchanga), nil
+}
+
+// formatPanchangaForLLM formats a panchanga for LLM consumption
+func (ps *PanchangaService) formatPanchangaForLLM(panchanga *domain.Panchanga) string {
+	const timeFormat = "15:04"
+
+	formatted := "DAILY PANCHANGA\n"
+	formatted += fmt.Sprintf("Date: %s\n", panchanga.Date)
+	formatted += fmt.Sprintf("Location: %s\n", panchanga.Location.GetDisplayName())
+	formatted += fmt.Sprintf("Ayanamsa: %s\n", panchanga.Ayanamsa)
+	formatted += fmt.Sprintf("Sunrise: %s, Sunset: %s\n\n",
+		panchanga.Sunrise.Format(timeFormat), panchanga.Sunset.Format(timeFormat))
+
+	formatted += fmt.Sprintf("VARA: %s (lord %s)\n", panchanga.Vara.Name, panchanga.Vara.Lord)
+
+	formatLimbs := func(title string, limbs []domain.PanchangaLimb) string {
+		text := fmt.Sprintf("\n%s:\n", title)
+		for _, limb := range limbs {
+			name := limb.Name
+			if limb.Paksha != "" {
+				name = fmt.Sprintf("%s %s", limb.Paksha, limb.Name)
+			}
+			if limb.Lord != "" {
+				name = fmt.Sprintf("%s (lord %s)", name, limb.Lord)
+			}
+			text += fmt.Sprintf("• %s: %s - %s\n", name,
+				limb.Start.Format("Jan 2 15:04"), limb.End.Format("Jan 2 15:04"))
+		}
+		return text
+	}
+
+	formatted += formatLimbs("TITHI", panchanga.Tithis)
+	formatted += formatLimbs("NAKSHATRA", panchanga.Nakshatras)
+	formatted += formatLimbs("YOGA", panchanga.Yogas)
+	formatted += formatLimbs("KARANA", panchanga.Karanas)
+
+	formatted += "\nINAUSPICIOUS PERIODS:\n"
+	formatted += fmt.Sprintf("• Rahu Kalam: %s - %s\n",
+		panchanga.RahuKalam.Start.Format(timeFormat), panchanga.RahuKalam.End.Format(timeFormat))
+	formatted += fmt.Sprintf("• Yamaganda: %s - %s\n",
+		panchanga.Yamaganda.Start.Format(timeFormat), panchanga.Yamaganda.End.Format(timeFormat))
+	formatted += fmt.Sprintf("• Gulika: %s - %s\n",
+		panchanga.Gulika.Start.Format(timeFormat), panchanga.Gulika.End.Format(timeFormat))
+
+	return formatted
+}
+
+// ValidatePanchangaRequest validates a panchanga request
+func (ps *PanchangaService) ValidatePanchangaRequest(req *PanchangaRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.City == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	if req.Ayanamsa != "" && !astro.IsValidAyanamsa(req.Ayanamsa) {
+		return fmt.Errorf("invalid ayanamsa: %s", req.Ayanamsa)
+	}
+
+	return nil
+}
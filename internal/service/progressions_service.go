@@ -10,17 +10,23 @@ import (
 
 // ProgressionsService handles secondary progressions calculations
 type ProgressionsService struct {
-	natalService *NatalService
-	logger       *logging.Logger
+	natalService     *NatalService
+	aspectCalculator *astro.AspectCalculator
+	logger           *logging.Logger
 }
 
+// progressedAspectOrb is the orb of aspects from progressed to natal positions. Progressed
+// planets move about a degree a year, so a wider orb would span several years.
+const progressedAspectOrb = 1.0
+
 // NewProgressionsService creates a new progressions service
 func NewProgressionsService(logger *logging.Logger) *ProgressionsService {
 	natalService := NewNatalService(logger)
 
 	return &ProgressionsService{
-		natalService: natalService,
-		logger:       logger,
+		natalService:     natalService,
+		aspectCalculator: astro.NewFixedOrbAspectCalculator(progressedAspectOrb),
+		logger:           logger,
 	}
 }
 
@@ -39,22 +45,24 @@ type ProgressionsRequest struct {
 	ProgressionYear  int `json:"progression_year" binding:"required"`
 
 	// Chart options
-	HouseSystem string `json:"house_system,omitempty"`
-	DrawChart   bool   `json:"draw_chart,omitempty"`
-	SVGWidth    int    `json:"svg_width,omitempty"`
-	SVGTheme    string `json:"svg_theme,omitempty"`
-	AIResponse  bool   `json:"ai_response,omitempty"`
+	HouseSystem string   `json:"house_system,omitempty"`
+	Bodies      []string `json:"bodies,omitempty"` // optional extra bodies for both charts, e.g. ["ceres", "lilith"]
+	DrawChart   bool     `json:"draw_chart,omitempty"`
+	SVGWidth    int      `json:"svg_width,omitempty"`
+	SVGTheme    string   `json:"svg_theme,omitempty"`
+	AIResponse  bool     `json:"ai_response,omitempty"`
 }
 
 // ProgressionsResponse represents the response from progressions calculation
 type ProgressionsResponse struct {
-	NatalChart          *domain.Chart `json:"natal_chart"`
-	ProgressedChart     *domain.Chart `json:"progressed_chart"`
-	ProgressionDate     string        `json:"progression_date"`
-	YearsProgressed     float64       `json:"years_progressed"`
-	DaysProgressed      float64       `json:"days_progressed"`
-	ChartDraw           string        `json:"chart_draw,omitempty"`
-	AIFormattedResponse *string       `json:"ai_formatted_response,omitempty"`
+	NatalChart          *domain.Chart   `json:"natal_chart"`
+	ProgressedChart     *domain.Chart   `json:"progressed_chart"`
+	ProgressedAspects   []domain.Aspect `json:"progressed_aspects"` // Progressed planets and angles (planet1) to natal planets and angles (planet2)
+	ProgressionDate     string          `json:"progression_date"`
+	YearsProgressed     float64         `json:"years_progressed"`
+	DaysProgressed      float64         `json:"days_progressed"`
+	ChartDraw           string          `json:"chart_draw,omitempty"`
+	AIFormattedResponse *string         `json:"ai_formatted_response,omitempty"`
 }
 
 // CalculateProgressions calculates secondary progressions
@@ -74,6 +82,7 @@ func (ps *ProgressionsService) CalculateProgressions(req *ProgressionsRequest) (
 		LocalTime:   req.BirthTime,
 		City:        req.BirthCity,
 		HouseSystem: req.HouseSystem,
+		Bodies:      req.Bodies,
 		DrawChart:   false,
 	}
 
@@ -101,6 +110,7 @@ func (ps *ProgressionsService) CalculateProgressions(req *ProgressionsRequest) (
 		LocalTime:   req.BirthTime, // Keep same birth time
 		City:        req.BirthCity, // Keep same birth location
 		HouseSystem: req.HouseSystem,
+		Bodies:      req.Bodies,
 		DrawChart:   req.DrawChart,
 		SVGWidth:    req.SVGWidth,
 		SVGTheme:    req.SVGTheme,
@@ -118,18 +128,28 @@ func (ps *ProgressionsService) CalculateProgressions(req *ProgressionsRequest) (
 
 	progressionDate := fmt.Sprintf("%d-%02d-%02d", req.ProgressionYear, req.ProgressionMonth, req.ProgressionDay)
 
+	// Aspects from the progressed chart to the natal planets and angles
+	progressedAspects := ps.aspectCalculator.CalculateAspectsBetweenChartsWithPoints(
+		progressedResponse.Chart.Planets,
+		progressedResponse.Chart.GetAnglePoints(),
+		natalResponse.Chart.Planets,
+		natalResponse.Chart.GetAnglePoints(),
+	)
+
 	response := &ProgressionsResponse{
-		NatalChart:      natalResponse.Chart,
-		ProgressedChart: progressedResponse.Chart,
-		ProgressionDate: progressionDate,
-		YearsProgressed: yearsProgressed,
-		DaysProgressed:  daysProgressed,
-		ChartDraw:       progressedResponse.Chart.ChartDraw,
+		NatalChart:        natalResponse.Chart,
+		ProgressedChart:   progressedResponse.Chart,
+		ProgressedAspects: progressedAspects,
+		ProgressionDate:   progressionDate,
+		YearsProgressed:   yearsProgressed,
+		DaysProgressed:    daysProgressed,
+		ChartDraw:         progressedResponse.Chart.ChartDraw,
 	}
 
 	ps.logger.Info().
 		Float64("years_progressed", yearsProgressed).
 		Float64("days_progressed", daysProgressed).
+		Int("progressed_aspects", len(progressedAspects)).
 		Msg("✨ Progressions calculation completed successfully")
 
 	return response, nil
@@ -266,6 +286,16 @@ func (ps *ProgressionsService) formatProgressionsForLLM(response *ProgressionsRe
 		formatted += "\n"
 	}
 
+	// Progressed to natal aspects
+	if len(response.ProgressedAspects) > 0 {
+		formatted += "PROGRESSED TO NATAL ASPECTS:\n"
+		for _, aspect := range response.ProgressedAspects {
+			formatted += fmt.Sprintf("• Progressed %s %s natal %s - %.2f° orb\n",
+				aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+		}
+		formatted += "\n"
+	}
+
 	// Progressions interpretation
 	formatted += "PROGRESSIONS INTERPRETATION:\n"
 	formatted += fmt.Sprintf("These secondary progressions show your inner development and evolving consciousness over %.1f years. ",
@@ -308,3 +338,16 @@ func (ps *ProgressionsService) findSignificantProgressedChanges(natalPlanets, pr
 
 	return changes
 }
+
+// ValidateProgressionsRequest validates a progressions request
+func (ps *ProgressionsService) ValidateProgressionsRequest(req *ProgressionsRequest) error {
+	if req.HouseSystem != "" && !astro.IsValidHouseSystem(req.HouseSystem) {
+		return fmt.Errorf("invalid house system: %s", req.HouseSystem)
+	}
+
+	if _, err := astro.ParseBodies(req.Bodies); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -37,11 +37,12 @@ type SolarReturnRequest struct {
 	ReturnCity string `json:"return_city,omitempty"` // If different from birth city
 
 	// Chart options
-	HouseSystem string `json:"house_system,omitempty"`
-	DrawChart   bool   `json:"draw_chart,omitempty"`
-	SVGWidth    int    `json:"svg_width,omitempty"`
-	SVGTheme    string `json:"svg_theme,omitempty"`
-	AIResponse  bool   `json:"ai_response,omitempty"`
+	HouseSystem string   `json:"house_system,omitempty"`
+	Bodies      []string `json:"bodies,omitempty"` // optional extra bodies for both charts, e.g. ["ceres", "lilith"]
+	DrawChart   bool     `json:"draw_chart,omitempty"`
+	SVGWidth    int      `json:"svg_width,omitempty"`
+	SVGTheme    string   `json:"svg_theme,omitempty"`
+	AIResponse  bool     `json:"ai_response,omitempty"`
 }
 
 // SolarReturnResponse represents the response from solar return calculation
@@ -71,6 +72,7 @@ func (srs *SolarReturnService) CalculateSolarReturn(req *SolarReturnRequest) (*S
 		LocalTime:   req.BirthTime,
 		City:        req.BirthCity,
 		HouseSystem: req.HouseSystem,
+		Bodies:      req.Bodies,
 		DrawChart:   false,
 	}
 
@@ -97,6 +99,7 @@ func (srs *SolarReturnService) CalculateSolarReturn(req *SolarReturnRequest) (*S
 		LocalTime:   req.BirthTime, // Approximate - would need precise calculation
 		City:        returnCity,
 		HouseSystem: req.HouseSystem,
+		Bodies:      req.Bodies,
 		DrawChart:   req.DrawChart,
 		SVGWidth:    req.SVGWidth,
 		SVGTheme:    req.SVGTheme,
@@ -159,6 +162,9 @@ func (srs *SolarReturnService) formatSolarReturnForLLM(response *SolarReturnResp
 	formatted += fmt.Sprintf("• Ascendant: %s %s\n", returnChart.Angles.Ascendant.Degree, returnChart.Angles.Ascendant.Sign)
 	formatted += fmt.Sprintf("• Midheaven: %s %s\n", returnChart.Angles.Midheaven.Degree, returnChart.Angles.Midheaven.Sign)
 
+	// Solar return overview
+	formatted += formatChartOverview(returnChart.Overview)
+
 	// Major aspects in solar return
 	majorAspects := astro.FilterMajorAspects(returnChart.Aspects)
 	if len(majorAspects) > 0 {
@@ -205,3 +211,16 @@ func (srs *SolarReturnService) getSignKeyword(sign string) string {
 	}
 	return "personal growth"
 }
+
+// ValidateSolarReturnRequest validates a solar return request
+func (srs *SolarReturnService) ValidateSolarReturnRequest(req *SolarReturnRequest) error {
+	if req.HouseSystem != "" && !astro.IsValidHouseSystem(req.HouseSystem) {
+		return fmt.Errorf("invalid house system: %s", req.HouseSystem)
+	}
+
+	if _, err := astro.ParseBodies(req.Bodies); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -9,34 +9,45 @@ import (
 
 // SynastryService handles synastry calculations
 type SynastryService struct {
-	natalService     *NatalService
-	aspectCalculator *astro.AspectCalculator
-	chartDrawer      *astro.ChartDrawer
-	logger           *logging.Logger
+	natalService          *NatalService
+	declinationCalculator *astro.DeclinationCalculator
+	antisciaCalculator    *astro.AntisciaCalculator
+	chartDrawer           *astro.ChartDrawer
+	logger                *logging.Logger
 }
 
 // NewSynastryService creates a new synastry service
 func NewSynastryService(logger *logging.Logger) *SynastryService {
 	natalService := NewNatalService(logger)
-	aspectCalc := astro.NewAspectCalculator()
 	chartDrawer := astro.NewChartDrawer()
 
 	return &SynastryService{
-		natalService:     natalService,
-		aspectCalculator: aspectCalc,
-		chartDrawer:      chartDrawer,
-		logger:           logger,
+		natalService:          natalService,
+		declinationCalculator: astro.NewDeclinationCalculator(),
+		antisciaCalculator:    astro.NewAntisciaCalculator(),
+		chartDrawer:           chartDrawer,
+		logger:                logger,
 	}
 }
 
 // SynastryRequest represents a request for synastry calculation
 type SynastryRequest struct {
-	Person1    PersonData `json:"person1" binding:"required"`
-	Person2    PersonData `json:"person2" binding:"required"`
-	DrawChart  bool       `json:"draw_chart,omitempty"`
-	SVGWidth   int        `json:"svg_width,omitempty"`
-	SVGTheme   string     `json:"svg_theme,omitempty"`
-	AIResponse bool       `json:"ai_response,omitempty"`
+	Person1         PersonData                    `json:"person1" binding:"required"`
+	Person2         PersonData                    `json:"person2" binding:"required"`
+	DeclinationOrb  float64                       `json:"declination_orb,omitempty"`  // orb for parallels and contraparallels (defaults to 1)
+	AntisciaOrb     float64                       `json:"antiscia_orb,omitempty"`     // orb for antiscia and contra-antiscia contacts (defaults to 1)
+	OrbProfile      string                        `json:"orb_profile,omitempty"`      // "standard", "tight", "wide" or "moiety" (defaults to "standard")
+	AspectOrbs      map[domain.AspectType]float64 `json:"aspect_orbs,omitempty"`      // base orb overrides per aspect; 0 disables the aspect
+	PlanetOrbs      map[string]float64            `json:"planet_orbs,omitempty"`      // orb adjustments per planet, or full planetary orbs with "moiety"
+	MinorAspects    bool                          `json:"minor_aspects,omitempty"`    // whether to include semisextiles, semisquares and sesquisquares
+	ExtendedAspects bool                          `json:"extended_aspects,omitempty"` // whether to include the quintile, septile, novile and decile series
+	PointOrbs       map[domain.PlanetType]float64 `json:"point_orbs,omitempty"`       // maximum orb per point type: "angle", "house_cusp", "lot", "lunar"
+	CustomAspects   []domain.CustomAspect         `json:"custom_aspects,omitempty"`   // extra aspects, e.g. {"name": "vigintile", "angle": 18, "orb": 1, "symbol": "V", "color": "#8e44ad"}
+	Bodies          []string                      `json:"bodies,omitempty"`           // optional extra bodies for both charts, e.g. ["ceres", "lilith"]
+	DrawChart       bool                          `json:"draw_chart,omitempty"`
+	SVGWidth        int                           `json:"svg_width,omitempty"`
+	SVGTheme        string                        `json:"svg_theme,omitempty"`
+	AIResponse      bool                          `json:"ai_response,omitempty"`
 }
 
 // PersonData represents birth data for one person
@@ -52,11 +63,13 @@ type PersonData struct {
 
 // SynastryResponse represents the response from synastry calculation
 type SynastryResponse struct {
-	Person1Chart        *domain.Chart   `json:"person1_chart"`
-	Person2Chart        *domain.Chart   `json:"person2_chart"`
-	SynastryAspects     []domain.Aspect `json:"synastry_aspects"`
-	ChartDraw           string          `json:"chart_draw,omitempty"`
-	AIFormattedResponse *string         `json:"ai_formatted_response,omitempty"`
+	Person1Chart        *domain.Chart              `json:"person1_chart"`
+	Person2Chart        *domain.Chart              `json:"person2_chart"`
+	SynastryAspects     []domain.Aspect            `json:"synastry_aspects"`
+	DeclinationAspects  []domain.DeclinationAspect `json:"declination_aspects"` // Parallels and contraparallels between the charts
+	AntisciaAspects     []domain.AntisciaAspect    `json:"antiscia_aspects"`    // Points of one chart on the antiscia of the other
+	ChartDraw           string                     `json:"chart_draw,omitempty"`
+	AIFormattedResponse *string                    `json:"ai_formatted_response,omitempty"`
 }
 
 // CalculateSynastry calculates synastry between two charts
@@ -64,31 +77,64 @@ func (ss *SynastryService) CalculateSynastry(req *SynastryRequest) (*SynastryRes
 	ss.logger.CalculationLogger().
 		Str("person1_city", req.Person1.City).
 		Str("person2_city", req.Person2.City).
+		Str("orb_profile", req.OrbProfile).
 		Bool("draw_chart", req.DrawChart).
 		Msg("🔮 Starting synastry calculation")
 
+	// The same orb settings apply to both natal charts and to the aspects between them
+	aspectSettings := req.aspectSettings()
+	aspectCalculator, err := astro.NewAspectCalculatorWithSettings(aspectSettings)
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate natal charts for both people
-	person1Chart, err := ss.calculatePersonChart(req.Person1)
+	person1Chart, err := ss.calculatePersonChart(req.Person1, aspectSettings, req.Bodies)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate chart for person 1: %w", err)
 	}
 
-	person2Chart, err := ss.calculatePersonChart(req.Person2)
+	person2Chart, err := ss.calculatePersonChart(req.Person2, aspectSettings, req.Bodies)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate chart for person 2: %w", err)
 	}
 
-	// Calculate synastry aspects
-	synastryAspects := ss.aspectCalculator.CalculateAspectsBetweenCharts(
+	// Calculate synastry aspects, including planets to the partner's angles. The second chart
+	// is held fixed, so applying aspects are those person 1's planets are moving toward.
+	synastryAspects := aspectCalculator.CalculateAspectsBetweenChartsWithPoints(
 		person1Chart.Planets,
+		person1Chart.GetAnglePoints(),
 		person2Chart.Planets,
+		person2Chart.GetAnglePoints(),
+	)
+
+	// Calculate parallels and contraparallels between the charts
+	if req.DeclinationOrb <= 0 {
+		req.DeclinationOrb = astro.DefaultDeclinationOrb
+	}
+	declinationAspects := ss.declinationCalculator.CalculateDeclinationAspectsBetweenCharts(
+		person1Chart.Planets,
+		person2Chart.Planets,
+		req.DeclinationOrb,
+	)
+
+	// Calculate antiscia contacts between the charts
+	if req.AntisciaOrb <= 0 {
+		req.AntisciaOrb = astro.DefaultAntisciaOrb
+	}
+	antisciaAspects := ss.antisciaCalculator.CalculateAntisciaAspectsBetweenCharts(
+		person1Chart.Antiscia,
+		person2Chart.Antiscia,
+		req.AntisciaOrb,
 	)
 
 	// Create response
 	response := &SynastryResponse{
-		Person1Chart:    person1Chart,
-		Person2Chart:    person2Chart,
-		SynastryAspects: synastryAspects,
+		Person1Chart:       person1Chart,
+		Person2Chart:       person2Chart,
+		SynastryAspects:    synastryAspects,
+		DeclinationAspects: declinationAspects,
+		AntisciaAspects:    antisciaAspects,
 	}
 
 	// Generate SVG chart if requested
@@ -111,23 +157,51 @@ func (ss *SynastryService) CalculateSynastry(req *SynastryRequest) (*SynastryRes
 
 	ss.logger.Info().
 		Int("synastry_aspects", len(synastryAspects)).
+		Int("declination_aspects", len(declinationAspects)).
+		Int("antiscia_aspects", len(antisciaAspects)).
 		Msg("✨ Synastry calculation completed successfully")
 
 	return response, nil
 }
 
+// aspectSettings returns the aspect orb settings of the request
+func (req *SynastryRequest) aspectSettings() astro.AspectSettings {
+	return astro.AspectSettings{
+		Profile:         req.OrbProfile,
+		AspectOrbs:      req.AspectOrbs,
+		PlanetOrbs:      req.PlanetOrbs,
+		MinorAspects:    req.MinorAspects,
+		ExtendedAspects: req.ExtendedAspects,
+		CustomAspects:   req.CustomAspects,
+		PointOrbs:       req.PointOrbs,
+	}
+}
+
 // calculatePersonChart converts PersonData to a natal chart
-func (ss *SynastryService) calculatePersonChart(person PersonData) (*domain.Chart, error) {
+func (ss *SynastryService) calculatePersonChart(
+	person PersonData,
+	aspectSettings astro.AspectSettings,
+	bodies []string,
+) (*domain.Chart, error) {
+
 	// Convert PersonData to NatalChartRequest
 	natalReq := &NatalChartRequest{
-		Day:         person.Day,
-		Month:       person.Month,
-		Year:        person.Year,
-		LocalTime:   person.LocalTime,
-		City:        person.City,
-		HouseSystem: person.HouseSystem,
-		DrawChart:   false, // Don't generate SVG for individual charts
-		AIResponse:  false,
+		Day:             person.Day,
+		Month:           person.Month,
+		Year:            person.Year,
+		LocalTime:       person.LocalTime,
+		City:            person.City,
+		HouseSystem:     person.HouseSystem,
+		OrbProfile:      aspectSettings.Profile,
+		AspectOrbs:      aspectSettings.AspectOrbs,
+		PlanetOrbs:      aspectSettings.PlanetOrbs,
+		MinorAspects:    aspectSettings.MinorAspects,
+		ExtendedAspects: aspectSettings.ExtendedAspects,
+		CustomAspects:   aspectSettings.CustomAspects,
+		PointOrbs:       aspectSettings.PointOrbs,
+		Bodies:          bodies,
+		DrawChart:       false, // Don't generate SVG for individual charts
+		AIResponse:      false,
 	}
 
 	// Calculate natal chart
@@ -186,6 +260,26 @@ func (ss *SynastryService) formatSynastryForLLM(response *SynastryResponse) stri
 		formatted += "\n"
 	}
 
+	// Declination Aspects
+	if len(response.DeclinationAspects) > 0 {
+		formatted += "DECLINATION ASPECTS:\n"
+		for _, aspect := range response.DeclinationAspects {
+			formatted += fmt.Sprintf("• %s %s %s (%.2f° orb)\n",
+				aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+		}
+		formatted += "\n"
+	}
+
+	// Antiscia
+	if len(response.AntisciaAspects) > 0 {
+		formatted += "ANTISCIA:\n"
+		for _, aspect := range response.AntisciaAspects {
+			formatted += fmt.Sprintf("• %s %s %s (%.2f° orb)\n",
+				aspect.Planet1, aspect.Type, aspect.Planet2, aspect.Orb)
+		}
+		formatted += "\n"
+	}
+
 	formatted += "SYNASTRY INTERPRETATION:\n"
 	formatted += "This synastry analysis shows the astrological connections between these two individuals. "
 	formatted += "The aspects between the planets reveal areas of harmony, tension, and growth potential in the relationship. "
@@ -193,3 +287,38 @@ func (ss *SynastryService) formatSynastryForLLM(response *SynastryResponse) stri
 
 	return formatted
 }
+
+// ValidateSynastryRequest validates a synastry request
+func (ss *SynastryService) ValidateSynastryRequest(req *SynastryRequest) error {
+	for _, person := range []PersonData{req.Person1, req.Person2} {
+		if person.Year < 1800 || person.Year > 2200 {
+			return fmt.Errorf("year must be between 1800 and 2200")
+		}
+
+		if person.City == "" {
+			return fmt.Errorf("city is required")
+		}
+
+		if person.HouseSystem != "" && !astro.IsValidHouseSystem(person.HouseSystem) {
+			return fmt.Errorf("invalid house system: %s", person.HouseSystem)
+		}
+	}
+
+	if req.DeclinationOrb > 3 {
+		return fmt.Errorf("declination_orb must be at most 3 degrees")
+	}
+
+	if req.AntisciaOrb > 5 {
+		return fmt.Errorf("antiscia_orb must be at most 5 degrees")
+	}
+
+	if _, err := astro.ParseBodies(req.Bodies); err != nil {
+		return err
+	}
+
+	if _, err := astro.NewAspectCalculatorWithSettings(req.aspectSettings()); err != nil {
+		return err
+	}
+
+	return nil
+}
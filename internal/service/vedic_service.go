@@ -0,0 +1,259 @@
+package service
+
+import (
+	"astroeph-api/internal/astro"
+	"astroeph-api/internal/domain"
+	"astroeph-api/internal/logging"
+	"astroeph-api/pkg/chart"
+	"fmt"
+)
+
+// VedicService handles sidereal (Jyotish) chart calculations
+type VedicService struct {
+	ephemeris       *astro.Ephemeris
+	vedicCalculator *astro.VedicCalculator
+	chartDrawer     *astro.ChartDrawer
+	logger          *logging.Logger
+}
+
+// NewVedicService creates a new Vedic chart service
+func NewVedicService(logger *logging.Logger) *VedicService {
+	ephemeris, err := astro.NewEphemeris(logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to initialize ephemeris for vedic service")
+		return nil
+	}
+
+	return &VedicService{
+		ephemeris:       ephemeris,
+		vedicCalculator: astro.NewVedicCalculator(ephemeris),
+		chartDrawer:     astro.NewChartDrawer(),
+		logger:          logger,
+	}
+}
+
+// VedicChartRequest represents a request for a sidereal chart with divisional charts
+type VedicChartRequest struct {
+	Day        int    `json:"day" binding:"required,min=1,max=31"`
+	Month      int    `json:"month" binding:"required,min=1,max=12"`
+	Year       int    `json:"year" binding:"required"`
+	LocalTime  string `json:"local_time" binding:"required"` // HH:MM:SS format
+	City       string `json:"city" binding:"required"`
+	Ayanamsa   string `json:"ayanamsa,omitempty"`    // defaults to "Lahiri"
+	Divisions  []int  `json:"divisions,omitempty"`   // e.g. [1, 9, 10]; defaults to all supported vargas
+	DrawChart  bool   `json:"draw_chart,omitempty"`  // whether to generate SVG charts
+	ChartStyle string `json:"chart_style,omitempty"` // "north" or "south" (defaults to "south")
+	SVGWidth   int    `json:"svg_width,omitempty"`   // width of SVG charts (defaults to 600)
+	SVGTheme   string `json:"svg_theme,omitempty"`   // theme for SVG charts ("light", "dark", "mono")
+	AIResponse bool   `json:"ai_response,omitempty"` // whether to format response for LLM
+
+	IncludeAshtakavarga bool `json:"include_ashtakavarga,omitempty"` // whether to calculate Bhinna/Sarva ashtakavarga
+	IncludeShadbala     bool `json:"include_shadbala,omitempty"`     // whether to calculate six-fold planetary strengths
+}
+
+// VedicChartResponse represents the response from a Vedic chart calculation
+type VedicChartResponse struct {
+	BirthInfo           domain.BirthInfo     `json:"birth_info"`
+	Ayanamsa            string               `json:"ayanamsa"`
+	AyanamsaValue       float64              `json:"ayanamsa_value"`
+	Ascendant           domain.ChartAngle    `json:"ascendant"`
+	Planets             []domain.Planet      `json:"planets"`
+	Vargas              []domain.VargaChart  `json:"vargas"`
+	Ashtakavarga        *domain.Ashtakavarga `json:"ashtakavarga,omitempty"`
+	Shadbala            []domain.Shadbala    `json:"shadbala,omitempty"`
+	AIFormattedResponse *string              `json:"ai_formatted_response,omitempty"`
+}
+
+// CalculateVedicChart calculates the sidereal rasi chart and the requested divisional charts
+func (vs *VedicService) CalculateVedicChart(req *VedicChartRequest) (*VedicChartResponse, error) {
+	vs.logger.CalculationLogger().
+		Str("city", req.City).
+		Int("year", req.Year).
+		Int("month", req.Month).
+		Int("day", req.Day).
+		Str("ayanamsa", req.Ayanamsa).
+		Ints("divisions", req.Divisions).
+		Bool("draw_chart", req.DrawChart).
+		Bool("include_ashtakavarga", req.IncludeAshtakavarga).
+		Bool("include_shadbala", req.IncludeShadbala).
+		Msg("🔮 Starting vedic chart calculation")
+
+	// Set defaults
+	if req.Ayanamsa == "" {
+		req.Ayanamsa = "Lahiri"
+	}
+	if req.SVGWidth <= 0 && req.DrawChart {
+		req.SVGWidth = 600
+	}
+
+	geocodingService := astro.GetGeocodingService()
+	if geocodingService == nil {
+		return nil, fmt.Errorf("geocoding service not available")
+	}
+
+	location, err := geocodingService.GetCityInfo(req.City)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location for %s: %w", req.City, err)
+	}
+
+	timeInfo, err := domain.ParseTime(req.Year, req.Month, req.Day, req.LocalTime, location.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	positions, err := vs.vedicCalculator.CalculateSiderealPositions(timeInfo, location, req.Ayanamsa)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate sidereal positions: %w", err)
+	}
+
+	vargas, err := vs.vedicCalculator.CalculateVargas(positions, req.Divisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate divisional charts: %w", err)
+	}
+
+	// Generate SVG charts if requested
+	if req.DrawChart {
+		theme := vs.chartDrawer.GetThemeFromString(req.SVGTheme)
+		style := chart.IndianChartStyle(req.ChartStyle)
+		for i := range vargas {
+			svg, err := vs.chartDrawer.GenerateVargaChart(&vargas[i], style, req.SVGWidth, theme)
+			if err != nil {
+				vs.logger.Error().
+					Err(err).
+					Str("varga", vargas[i].Code).
+					Msg("Failed to generate varga chart SVG")
+				// Don't fail the entire request if SVG generation fails
+				continue
+			}
+			vargas[i].ChartDraw = svg
+		}
+	}
+
+	response := &VedicChartResponse{
+		BirthInfo: domain.BirthInfo{
+			Date:     timeInfo.FormatDateForDisplay(),
+			Time:     timeInfo.FormatTimeOnly(),
+			Location: *location,
+		},
+		Ayanamsa:      positions.Ayanamsa,
+		AyanamsaValue: positions.AyanamsaValue,
+		Ascendant: domain.ChartAngle{
+			Sign:   domain.GetZodiacSign(positions.Ascendant),
+			Degree: domain.FormatDegreeInSign(positions.Ascendant),
+			Value:  positions.Ascendant,
+		},
+		Planets: positions.Planets,
+		Vargas:  vargas,
+	}
+
+	if req.IncludeAshtakavarga {
+		ashtakavarga, err := vs.vedicCalculator.CalculateAshtakavarga(positions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate ashtakavarga: %w", err)
+		}
+		response.Ashtakavarga = ashtakavarga
+	}
+
+	if req.IncludeShadbala {
+		shadbala, err := vs.vedicCalculator.CalculateShadbala(timeInfo, location, positions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate shadbala: %w", err)
+		}
+		response.Shadbala = shadbala
+	}
+
+	vs.logger.Info().
+		Str("endpoint", "vedic-chart").
+		Str("ayanamsa", positions.Ayanamsa).
+		Int("vargas_calculated", len(vargas)).
+		Msg("✨ Vedic chart calculation completed successfully")
+
+	return response, nil
+}
+
+// GetVedicChartFormatted returns a formatted Vedic chart for LLM consumption
+func (vs *VedicService) GetVedicChartFormatted(req *VedicChartRequest) (string, error) {
+	response, err := vs.CalculateVedicChart(req)
+	if err != nil {
+		return "", err
+	}
+
+	return vs.formatVedicChartForLLM(response), nil
+}
+
+// formatVedicChartForLLM formats a Vedic chart for LLM consumption
+func (vs *VedicService) formatVedicChartForLLM(response *VedicChartResponse) string {
+	formatted := "VEDIC (SIDEREAL) CHART ANALYSIS\n"
+	formatted += fmt.Sprintf("Birth Date: %s at %s\n", response.BirthInfo.Date, response.BirthInfo.Time)
+	formatted += fmt.Sprintf("Birth Location: %s\n", response.BirthInfo.Location.GetDisplayName())
+	formatted += fmt.Sprintf("Ayanamsa: %s (%.4f°)\n", response.Ayanamsa, response.AyanamsaValue)
+	formatted += fmt.Sprintf("Lagna: %s %s\n\n", response.Ascendant.Degree, response.Ascendant.Sign)
+
+	formatted += "GRAHA POSITIONS (RASI):\n"
+	for _, planet := range response.Planets {
+		retrograde := ""
+		if planet.IsRetrograde {
+			retrograde = " (R)"
+		}
+		formatted += fmt.Sprintf("• %s: %s %s%s (House %d)\n",
+			planet.Name, planet.Degree, planet.Sign, retrograde, planet.House)
+	}
+
+	for _, varga := range response.Vargas {
+		formatted += fmt.Sprintf("\n%s %s - Lagna in %s:\n", varga.Code, varga.Name, varga.Ascendant.Sign)
+		for _, placement := range varga.Placements {
+			formatted += fmt.Sprintf("• %s: %s (House %d)\n", placement.Name, placement.Sign, placement.House)
+		}
+	}
+
+	if response.Ashtakavarga != nil {
+		formatted += "\nSARVA ASHTAKAVARGA:\n"
+		for _, sign := range response.Ashtakavarga.Sarva {
+			formatted += fmt.Sprintf("• %s: %d bindus\n", sign.Sign, sign.Bindus)
+		}
+
+		formatted += "\nBHINNA ASHTAKAVARGA TOTALS:\n"
+		for _, bhinna := range response.Ashtakavarga.Bhinna {
+			formatted += fmt.Sprintf("• %s: %d bindus\n", bhinna.Planet, bhinna.Total)
+		}
+	}
+
+	if len(response.Shadbala) > 0 {
+		formatted += "\nSHADBALA (RUPAS):\n"
+		for _, bala := range response.Shadbala {
+			status := "weak"
+			if bala.IsStrong {
+				status = "strong"
+			}
+			formatted += fmt.Sprintf("• %s: %.2f of %.2f required (%.0f%%, %s)\n",
+				bala.Planet, bala.Rupas, bala.RequiredRupas, bala.StrengthRatio*100, status)
+		}
+	}
+
+	return formatted
+}
+
+// ValidateVedicChartRequest validates a Vedic chart request
+func (vs *VedicService) ValidateVedicChartRequest(req *VedicChartRequest) error {
+	if req.Year < 1800 || req.Year > 2200 {
+		return fmt.Errorf("year must be between 1800 and 2200")
+	}
+
+	if req.Ayanamsa != "" && !astro.IsValidAyanamsa(req.Ayanamsa) {
+		return fmt.Errorf("invalid ayanamsa: %s", req.Ayanamsa)
+	}
+
+	for _, division := range req.Divisions {
+		if !domain.IsValidVargaDivision(division) {
+			return fmt.Errorf("unsupported divisional chart: D%d", division)
+		}
+	}
+
+	if req.ChartStyle != "" &&
+		req.ChartStyle != string(chart.IndianChartNorth) &&
+		req.ChartStyle != string(chart.IndianChartSouth) {
+		return fmt.Errorf("invalid chart style: %s (use \"north\" or \"south\")", req.ChartStyle)
+	}
+
+	return nil
+}
@@ -349,6 +349,12 @@ func (c *Chart) generateBodyWheel(wheelRadius float64, data *ChartData, minDegre
 				`<g fill="%s" stroke="%s" stroke-width="%.1f" transform="translate(%.1f, %.1f) scale(%.3f)">%s</g>`,
 				fillAttr, strokeAttr, float64(c.Config.Chart.StrokeWidth)*1.5, symbolX-c.PosAdj, symbolY-c.PosAdj, symbolScale, svgPath,
 			))
+		} else {
+			// Bodies without a glyph file fall back to their text symbol
+			elements = append(elements, fmt.Sprintf(
+				`<text x="%.1f" y="%.1f" font-size="%.1f" fill="%s" text-anchor="middle" dominant-baseline="central">%s</text>`,
+				symbolX, symbolY, c.FontSize*0.7, strokeColor, body.Symbol,
+			))
 		}
 	}
 
@@ -393,34 +399,47 @@ func (c *Chart) generateAspectLines(radius float64, aspects []Aspect) []string {
 		startAngle := math.Pi * aspect.Body1.NormalizedDegree / 180
 		endAngle := math.Pi * aspect.Body2.NormalizedDegree / 180
 
-		orbConfig := c.Config.GetOrbForAspect(aspect.AspectMember.Name)
-		if orbConfig == 0 {
-			continue
-		}
+		// Precalculated aspects carry their strength, otherwise it follows from the configured orb
+		var opacityFactor float64
+		if aspect.Strength != nil {
+			opacityFactor = *aspect.Strength
+		} else {
+			orbConfig := c.Config.GetOrbForAspect(aspect.AspectMember.Name)
+			if orbConfig == 0 {
+				continue
+			}
 
-		orb := 1.0
-		if aspect.Orb != nil {
-			orb = *aspect.Orb
+			orb := 1.0
+			if aspect.Orb != nil {
+				orb = *aspect.Orb
+			}
+			opacityFactor = 1 - orb/float64(orbConfig)
 		}
-
-		orbFraction := 1 - orb/float64(orbConfig)
-		opacityFactor := orbFraction
 		if aspect.AspectMember.Name == "conjunction" {
 			opacityFactor = 1.0
 		}
 
 		strokeColor := c.getColorForElement(aspect.AspectMember.Color, theme)
+		x1 := c.CX - radius*math.Cos(startAngle)
+		y1 := c.CY + radius*math.Sin(startAngle)
+		x2 := c.CX - radius*math.Cos(endAngle)
+		y2 := c.CY + radius*math.Sin(endAngle)
 
 		elements = append(elements, fmt.Sprintf(
 			`<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="%.1f" stroke-opacity="%.3f"/>`,
-			c.CX-radius*math.Cos(startAngle),
-			c.CY+radius*math.Sin(startAngle),
-			c.CX-radius*math.Cos(endAngle),
-			c.CY+radius*math.Sin(endAngle),
+			x1, y1, x2, y2,
 			strokeColor,
 			float64(c.Config.Chart.StrokeWidth)/2,
 			c.Config.Chart.StrokeOpacity*opacityFactor,
 		))
+
+		// Minor, harmonic and custom aspects are labelled with their glyph at the middle of the line
+		if hasAspectGlyph(aspect.AspectMember.Name) && aspect.AspectMember.Symbol != "" {
+			elements = append(elements, fmt.Sprintf(
+				`<text x="%.1f" y="%.1f" fill="%s" font-size="%.1f" text-anchor="middle" dominant-baseline="central">%s</text>`,
+				(x1+x2)/2, (y1+y2)/2, strokeColor, c.FontSize*0.5, aspect.AspectMember.Symbol,
+			))
+		}
 	}
 
 	return elements
@@ -509,6 +528,10 @@ func (c *Chart) getColorForElement(element string, theme Theme) string {
 	case "others":
 		return theme.Others
 	default:
+		// Custom aspects may carry their own hex color
+		if strings.HasPrefix(element, "#") {
+			return element
+		}
 		return theme.Foreground
 	}
 }
@@ -78,6 +78,7 @@ type Aspect struct {
 	AspectMember AspectMember `json:"aspect_member"`
 	Applying     *bool        `json:"applying,omitempty"`
 	Orb          *float64     `json:"orb,omitempty"`
+	Strength     *float64     `json:"strength,omitempty"` // 0-1, used for the line opacity when set
 }
 
 // ChartData contains all the astrological data for generating a chart
@@ -283,21 +284,20 @@ func (cd *ChartData) createAspects(response *domain.Chart) {
 			continue // Skip if we can't find both bodies
 		}
 
-		// Find aspect member
-		aspectMember := GetAspectMember(string(modelAspect.Type))
-		if aspectMember == nil {
-			continue
-		}
+		// Find aspect member, custom aspects carry their own glyph and color
+		aspectMember := getAspectMemberOrCustom(string(modelAspect.Type), modelAspect.Symbol, modelAspect.Color)
 
 		// Convert orb from float64 to the expected format
 		orb := modelAspect.Orb
+		strength := modelAspect.Strength
 
 		aspect := Aspect{
 			Body1:        body1,
 			Body2:        body2,
-			AspectMember: *aspectMember,
+			AspectMember: aspectMember,
 			Applying:     &modelAspect.IsApplying,
 			Orb:          &orb,
+			Strength:     &strength,
 		}
 
 		cd.Aspects = append(cd.Aspects, aspect)
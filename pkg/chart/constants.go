@@ -37,7 +37,7 @@ const (
 // Element and modality names
 var (
 	PLANET_NAMES   = []string{"sun", "moon", "mercury", "venus", "mars", "jupiter", "saturn", "uranus", "neptune", "pluto", "asc_node"}
-	EXTRA_NAMES    = []string{"chiron", "ceres", "pallas", "juno", "vesta"}
+	EXTRA_NAMES    = []string{"chiron", "ceres", "pallas", "juno", "vesta", "true_node", "dsc_node", "lilith", "osculating_lilith", "lot_of_fortune", "vertex", "east_point", "co_ascendant_koch", "co_ascendant_munkasey", "polar_ascendant"}
 	ELEMENT_NAMES  = []string{"fire", "earth", "air", "water"}
 	MODALITY_NAMES = []string{"cardinal", "fixed", "mutable"}
 	POLARITY_NAMES = []string{"positive", "negative"}
@@ -127,6 +127,19 @@ var ASPECT_MEMBERS = []AspectMember{
 	{Body: Body{Name: "square", Symbol: "□", Value: 90, Color: "fire"}},
 	{Body: Body{Name: "sextile", Symbol: "⚹", Value: 60, Color: "points"}},
 	{Body: Body{Name: "quincunx", Symbol: "⚻", Value: 150, Color: "asteroids"}},
+	{Body: Body{Name: "semisextile", Symbol: "⚺", Value: 30, Color: "points"}},
+	{Body: Body{Name: "semisquare", Symbol: "∠", Value: 45, Color: "fire"}},
+	{Body: Body{Name: "sesquisquare", Symbol: "⚼", Value: 135, Color: "fire"}},
+	{Body: Body{Name: "quintile", Symbol: "Q", Value: 72, Color: "earth"}},
+	{Body: Body{Name: "biquintile", Symbol: "bQ", Value: 144, Color: "earth"}},
+	{Body: Body{Name: "septile", Symbol: "S", Value: 51, Color: "water"}},
+	{Body: Body{Name: "biseptile", Symbol: "bS", Value: 103, Color: "water"}},
+	{Body: Body{Name: "triseptile", Symbol: "tS", Value: 154, Color: "water"}},
+	{Body: Body{Name: "novile", Symbol: "N", Value: 40, Color: "air"}},
+	{Body: Body{Name: "binovile", Symbol: "bN", Value: 80, Color: "air"}},
+	{Body: Body{Name: "quadnovile", Symbol: "qN", Value: 160, Color: "air"}},
+	{Body: Body{Name: "decile", Symbol: "D", Value: 36, Color: "asteroids"}},
+	{Body: Body{Name: "tredecile", Symbol: "tD", Value: 108, Color: "asteroids"}},
 }
 
 // ELEMENT_MEMBERS contains all element definitions
@@ -189,6 +202,16 @@ var EXTRA_MEMBERS = []ExtraMember{
 	{Body: Body{Name: "pallas", Symbol: "⚴", Value: 18, Color: "asteroids"}},
 	{Body: Body{Name: "juno", Symbol: "⚵", Value: 19, Color: "asteroids"}},
 	{Body: Body{Name: "vesta", Symbol: "⚶", Value: 20, Color: "asteroids"}},
+	{Body: Body{Name: "true_node", Symbol: "☊", Value: 11, Color: "points"}},
+	{Body: Body{Name: "dsc_node", Symbol: "☋", Value: 10, Color: "points"}},
+	{Body: Body{Name: "lilith", Symbol: "⚸", Value: 12, Color: "points"}},
+	{Body: Body{Name: "osculating_lilith", Symbol: "⚸", Value: 13, Color: "points"}},
+	{Body: Body{Name: "lot_of_fortune", Symbol: "⊗", Value: 0, Color: "points"}},
+	{Body: Body{Name: "vertex", Symbol: "Vx", Value: 0, Color: "points"}},
+	{Body: Body{Name: "east_point", Symbol: "EP", Value: 0, Color: "points"}},
+	{Body: Body{Name: "co_ascendant_koch", Symbol: "CAk", Value: 0, Color: "points"}},
+	{Body: Body{Name: "co_ascendant_munkasey", Symbol: "CAm", Value: 0, Color: "points"}},
+	{Body: Body{Name: "polar_ascendant", Symbol: "PA", Value: 0, Color: "points"}},
 }
 
 // VERTEX_MEMBERS contains vertex definitions
@@ -216,3 +239,23 @@ func GetAspectMember(name string) *AspectMember {
 	}
 	return nil
 }
+
+// getAspectMemberOrCustom returns the aspect member by name, or builds one for a custom
+// aspect from its glyph and color. Custom aspects without a color use the foreground color.
+func getAspectMemberOrCustom(name, symbol, color string) AspectMember {
+	if member := GetAspectMember(name); member != nil {
+		return *member
+	}
+	return AspectMember{Body: Body{Name: name, Symbol: symbol, Color: color}}
+}
+
+// hasAspectGlyph returns true for aspects drawn with their glyph. The main aspects in
+// ASPECT_NAMES are identified by the color of their line alone.
+func hasAspectGlyph(name string) bool {
+	for _, aspectName := range ASPECT_NAMES {
+		if aspectName == name {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,242 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// DialChartData contains the data needed to draw a Uranian / Cosmobiology dial
+type DialChartData struct {
+	Title  string     `json:"title"`
+	Dial   float64    `json:"dial"` // Dial size in degrees: 360, 90, 45 or 22.5
+	Bodies []DialBody `json:"bodies"`
+}
+
+// DialBody represents a body placed on the dial by its zodiac longitude
+type DialBody struct {
+	Name      string  `json:"name"`
+	Longitude float64 `json:"longitude"`
+}
+
+// dialScale returns the tick and label spacing (in dial degrees) for a dial size
+func dialScale(dial float64) (tick, label float64) {
+	switch {
+	case dial >= 360:
+		return 5, 30
+	case dial >= 45:
+		return 1, 5
+	default:
+		return 0.5, 2.5
+	}
+}
+
+// GenerateDialSVG generates a dial where the zodiac is wrapped dial times around the circle.
+// The pointer at the top marks 0° and hard aspects of the dial size appear as conjunctions.
+func GenerateDialSVG(data *DialChartData, width int, themeType *ThemeType) (*ChartResponse, error) {
+	if data == nil {
+		return nil, fmt.Errorf("dial chart data is required")
+	}
+
+	if data.Dial <= 0 || data.Dial > 360 {
+		return nil, fmt.Errorf("invalid dial size: %.1f", data.Dial)
+	}
+
+	if width <= 0 {
+		width = 600
+	}
+
+	config := DefaultConfig()
+	if themeType != nil {
+		config.ThemeType = *themeType
+	}
+
+	return &ChartResponse{
+		SVG:    generateDialSVG(data, width, config),
+		Width:  width,
+		Height: width,
+	}, nil
+}
+
+// generateDialSVG draws the dial scale, the sector lines, the pointer and the bodies
+func generateDialSVG(data *DialChartData, width int, config Config) string {
+	theme := config.GetTheme()
+	size := float64(width)
+	center := size / 2
+	outerRadius := center - size*config.Chart.MarginFactor
+	scaleRadius := outerRadius * 0.88
+	bodyRadius := outerRadius * 0.72
+	fontSize := outerRadius / 14
+
+	// dialPoint returns the coordinates of a dial position, clockwise from the top
+	dialPoint := func(position, radius float64) (float64, float64) {
+		angle := position / data.Dial * 2 * math.Pi
+		return center + radius*math.Sin(angle), center - radius*math.Cos(angle)
+	}
+
+	var elements []string
+	elements = append(elements, fmt.Sprintf(`<rect x="0" y="0" width="%d" height="%d" fill="%s"/>`,
+		width, width, theme.Background))
+	elements = append(elements, dialCircle(center, outerRadius, theme.Foreground, config))
+	elements = append(elements, dialCircle(center, scaleRadius, theme.Foreground, config))
+
+	// Degree scale
+	tick, label := dialScale(data.Dial)
+	for position := 0.0; position < data.Dial-tick/2; position += tick {
+		length := (outerRadius - scaleRadius) * 0.25
+		if isDialMultiple(position, label) {
+			length = (outerRadius - scaleRadius) * 0.5
+			x, y := dialPoint(position, scaleRadius+(outerRadius-scaleRadius)*0.72)
+			elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle" dominant-baseline="central">%s</text>`,
+				x, y, fontSize*0.6, theme.Dim, formatDialDegree(position)))
+		}
+		x1, y1 := dialPoint(position, scaleRadius)
+		x2, y2 := dialPoint(position, scaleRadius+length)
+		elements = append(elements, dialLine(x1, y1, x2, y2, theme.Foreground, config))
+	}
+
+	// The 90° dial is divided into cardinal, fixed and mutable sectors of 30°
+	if data.Dial == 90 {
+		sectors := []struct {
+			start float64
+			name  string
+			color string
+		}{
+			{0, "Cardinal", theme.Fire},
+			{30, "Fixed", theme.Earth},
+			{60, "Mutable", theme.Air},
+		}
+		for _, sector := range sectors {
+			x1, y1 := dialPoint(sector.start, 0)
+			x2, y2 := dialPoint(sector.start, scaleRadius)
+			elements = append(elements, dialLine(x1, y1, x2, y2, theme.Dim, config))
+
+			x, y := dialPoint(sector.start+15, scaleRadius*0.3)
+			elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle" dominant-baseline="central">%s</text>`,
+				x, y, fontSize*0.6, sector.color, sector.name))
+		}
+	}
+
+	// Pointer at 0°
+	px, py := dialPoint(0, outerRadius)
+	elements = append(elements, fmt.Sprintf(`<polygon points="%.2f,%.2f %.2f,%.2f %.2f,%.2f" fill="%s"/>`,
+		px, py-fontSize*0.2, px-fontSize*0.35, py-fontSize*0.8, px+fontSize*0.35, py-fontSize*0.8, theme.Fire))
+
+	// Bodies, stepped inwards when they crowd each other
+	bodies := append([]DialBody(nil), data.Bodies...)
+	for i := range bodies {
+		bodies[i].Longitude = dialPosition(bodies[i].Longitude, data.Dial)
+	}
+	sort.Slice(bodies, func(i, j int) bool {
+		return bodies[i].Longitude < bodies[j].Longitude
+	})
+
+	minSeparation := data.Dial / 60
+	level := 0
+	for i, body := range bodies {
+		if i > 0 && body.Longitude-bodies[i-1].Longitude < minSeparation {
+			level = (level + 1) % 4
+		} else {
+			level = 0
+		}
+
+		symbol, color := dialBodySymbol(body.Name, theme)
+		radius := bodyRadius - float64(level)*fontSize*1.2
+
+		x1, y1 := dialPoint(body.Longitude, scaleRadius)
+		x2, y2 := dialPoint(body.Longitude, radius+fontSize*0.7)
+		elements = append(elements, dialLine(x1, y1, x2, y2, color, config))
+
+		x, y := dialPoint(body.Longitude, radius)
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle" dominant-baseline="central">%s</text>`,
+			x, y, fontSize, color, symbol))
+	}
+
+	if data.Title != "" {
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle">%s</text>`,
+			center, size-fontSize*0.3, fontSize*0.7, theme.Dim, data.Title))
+	}
+
+	return fmt.Sprintf(`<svg height="%d" width="%d" font-family="%s" version="1.1" xmlns="http://www.w3.org/2000/svg">
+%s
+</svg>`, width, width, config.Chart.Font, strings.Join(elements, "\n"))
+}
+
+// dialBodySymbol returns the glyph (or abbreviation) and theme color of a body
+func dialBodySymbol(name string, theme Theme) (string, string) {
+	normalized := normalizeBodyNameForRaw(name)
+
+	var body *Body
+	for i := range PLANET_MEMBERS {
+		if PLANET_MEMBERS[i].Name == normalized {
+			body = &PLANET_MEMBERS[i]
+		}
+	}
+	for i := range EXTRA_MEMBERS {
+		if EXTRA_MEMBERS[i].Name == normalized {
+			body = &EXTRA_MEMBERS[i].Body
+		}
+	}
+	for i := range VERTEX_MEMBERS {
+		if VERTEX_MEMBERS[i].Name == normalized {
+			body = &VERTEX_MEMBERS[i].Body
+		}
+	}
+
+	if body == nil {
+		label := []rune(name)
+		if len(label) > 3 {
+			label = label[:3]
+		}
+		return string(label), theme.Foreground
+	}
+
+	switch body.Color {
+	case "fire":
+		return body.Symbol, theme.Fire
+	case "earth":
+		return body.Symbol, theme.Earth
+	case "air":
+		return body.Symbol, theme.Air
+	case "water":
+		return body.Symbol, theme.Water
+	case "points":
+		return body.Symbol, theme.Points
+	case "asteroids":
+		return body.Symbol, theme.Asteroids
+	default:
+		return body.Symbol, theme.Foreground
+	}
+}
+
+// dialPosition reduces a longitude to the dial
+func dialPosition(longitude, dial float64) float64 {
+	return math.Mod(normalizeAngle360(longitude), dial)
+}
+
+// isDialMultiple returns true if position is (numerically) a multiple of step
+func isDialMultiple(position, step float64) bool {
+	remainder := math.Mod(position, step)
+	return remainder < 1e-6 || step-remainder < 1e-6
+}
+
+// formatDialDegree formats a dial degree, keeping one decimal only when needed
+func formatDialDegree(position float64) string {
+	if position == math.Trunc(position) {
+		return fmt.Sprintf("%.0f", position)
+	}
+	return fmt.Sprintf("%.1f", position)
+}
+
+// dialCircle draws an unfilled circle
+func dialCircle(center, radius float64, color string, config Config) string {
+	return fmt.Sprintf(`<circle cx="%.2f" cy="%.2f" r="%.2f" fill="none" stroke="%s" stroke-width="%d"/>`,
+		center, center, radius, color, config.Chart.StrokeWidth)
+}
+
+// dialLine draws a straight line
+func dialLine(x1, y1, x2, y2 float64, color string, config Config) string {
+	return fmt.Sprintf(`<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="%s" stroke-width="%d" stroke-opacity="%.2f"/>`,
+		x1, y1, x2, y2, color, config.Chart.StrokeWidth, config.Chart.StrokeOpacity)
+}
@@ -0,0 +1,236 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// DispositorChartData contains the data needed to draw a dispositor tree
+type DispositorChartData struct {
+	Title           string           `json:"title"`
+	Nodes           []DispositorNode `json:"nodes"`
+	FinalDispositor string           `json:"final_dispositor,omitempty"`
+}
+
+// DispositorNode is a planet and the ruler of the sign it occupies
+type DispositorNode struct {
+	Name       string `json:"name"`
+	Dispositor string `json:"dispositor"`
+}
+
+// GenerateDispositorSVG draws the dispositor tree: the loops (and the final dispositor)
+// on the top row, and every other planet below the planet that disposes of it.
+func GenerateDispositorSVG(data *DispositorChartData, width int, themeType *ThemeType) (*ChartResponse, error) {
+	if data == nil || len(data.Nodes) == 0 {
+		return nil, fmt.Errorf("dispositor chart data is required")
+	}
+
+	if width <= 0 {
+		width = 600
+	}
+
+	config := DefaultConfig()
+	if themeType != nil {
+		config.ThemeType = *themeType
+	}
+
+	rows := layoutDispositorRows(data.Nodes)
+	rowHeight := float64(width) / 6
+	height := int(rowHeight * (float64(len(rows)) + 1))
+
+	return &ChartResponse{
+		SVG:    generateDispositorSVG(data, rows, width, height, config),
+		Width:  width,
+		Height: height,
+	}, nil
+}
+
+// layoutDispositorRows groups the planets by their distance from a loop. Row 0 holds the
+// loops (cycle members kept together) and planets whose dispositor is not in the chart.
+func layoutDispositorRows(nodes []DispositorNode) [][]string {
+	dispositors := make(map[string]string)
+	for _, node := range nodes {
+		dispositors[node.Name] = node.Dispositor
+	}
+
+	// Planets on a cycle
+	onCycle := make(map[string]bool)
+	for _, node := range nodes {
+		visited := map[string]bool{}
+		current := node.Name
+		for !visited[current] {
+			visited[current] = true
+			next, exists := dispositors[current]
+			if !exists {
+				break
+			}
+			current = next
+		}
+		onCycle[node.Name] = current == node.Name
+	}
+
+	depths := make(map[string]int)
+	var depthOf func(name string) int
+	depthOf = func(name string) int {
+		if depth, known := depths[name]; known {
+			return depth
+		}
+		next := dispositors[name]
+		if _, inChart := dispositors[next]; onCycle[name] || !inChart {
+			depths[name] = 0
+			return 0
+		}
+		depths[name] = depthOf(next) + 1
+		return depths[name]
+	}
+	for _, node := range nodes {
+		depthOf(node.Name)
+	}
+
+	maxDepth := 0
+	for _, depth := range depths {
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+	rows := make([][]string, maxDepth+1)
+
+	// Top row: walk each cycle in dispositor order so its members sit side by side
+	placed := make(map[string]bool)
+	for _, node := range nodes {
+		if depths[node.Name] != 0 || placed[node.Name] {
+			continue
+		}
+		current := node.Name
+		for !placed[current] && depths[current] == 0 {
+			rows[0] = append(rows[0], current)
+			placed[current] = true
+			if !onCycle[current] {
+				break
+			}
+			current = dispositors[current]
+		}
+	}
+
+	// Lower rows: children ordered by the position of their dispositor above
+	for depth := 1; depth <= maxDepth; depth++ {
+		index := make(map[string]int)
+		for i, name := range rows[depth-1] {
+			index[name] = i
+		}
+		for _, node := range nodes {
+			if depths[node.Name] == depth {
+				rows[depth] = append(rows[depth], node.Name)
+			}
+		}
+		sort.SliceStable(rows[depth], func(i, j int) bool {
+			return index[dispositors[rows[depth][i]]] < index[dispositors[rows[depth][j]]]
+		})
+	}
+
+	return rows
+}
+
+// generateDispositorSVG draws the rows of planets and the arrows to their dispositors
+func generateDispositorSVG(data *DispositorChartData, rows [][]string, width, height int, config Config) string {
+	theme := config.GetTheme()
+	size := float64(width)
+	rowHeight := size / 6
+
+	maxRow := 0
+	for _, row := range rows {
+		if len(row) > maxRow {
+			maxRow = len(row)
+		}
+	}
+	radius := math.Min(rowHeight*0.22, size/float64(maxRow+1)*0.3)
+	fontSize := radius * 1.1
+
+	// Node positions
+	type point struct{ x, y float64 }
+	positions := make(map[string]point)
+	for r, row := range rows {
+		step := size / float64(len(row)+1)
+		for i, name := range row {
+			positions[name] = point{step * float64(i+1), rowHeight * (float64(r) + 0.8)}
+		}
+	}
+
+	var elements []string
+	elements = append(elements, fmt.Sprintf(`<rect x="0" y="0" width="%d" height="%d" fill="%s"/>`,
+		width, height, theme.Background))
+	elements = append(elements, fmt.Sprintf(`<defs><marker id="dispositor-arrow" viewBox="0 0 10 10" refX="9" refY="5" markerWidth="6" markerHeight="6" orient="auto-start-reverse"><path d="M 0 0 L 10 5 L 0 10 z" fill="%s"/></marker></defs>`,
+		theme.Dim))
+
+	// Arrows from each planet to its dispositor
+	for _, node := range data.Nodes {
+		from, fromExists := positions[node.Name]
+		to, toExists := positions[node.Dispositor]
+		if !fromExists || !toExists {
+			continue
+		}
+
+		switch {
+		case node.Name == node.Dispositor:
+			// In its own domicile: a small loop above the planet
+			elements = append(elements, fmt.Sprintf(`<path d="M %.2f %.2f C %.2f %.2f %.2f %.2f %.2f %.2f" fill="none" stroke="%s" stroke-width="%d" marker-end="url(#dispositor-arrow)"/>`,
+				from.x-radius*0.6, from.y-radius*0.8,
+				from.x-radius*1.6, from.y-radius*2.8,
+				from.x+radius*1.6, from.y-radius*2.8,
+				from.x+radius*0.6, from.y-radius*0.8,
+				theme.Dim, config.Chart.StrokeWidth))
+		case from.y == to.y:
+			// Between members of a loop on the same row: an arc above the row when
+			// pointing right and below it when pointing left
+			dx := to.x - from.x
+			side := -1.0
+			if dx < 0 {
+				side = 1
+			}
+			lift := radius + math.Abs(dx)*0.25
+			elements = append(elements, fmt.Sprintf(`<path d="M %.2f %.2f Q %.2f %.2f %.2f %.2f" fill="none" stroke="%s" stroke-width="%d" marker-end="url(#dispositor-arrow)"/>`,
+				from.x, from.y+side*radius,
+				from.x+dx/2, from.y+side*(radius+lift),
+				to.x, to.y+side*radius,
+				theme.Dim, config.Chart.StrokeWidth))
+		default:
+			// Up to the dispositor, stopping at the edge of both circles
+			angle := math.Atan2(to.y-from.y, to.x-from.x)
+			elements = append(elements, fmt.Sprintf(`<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="%s" stroke-width="%d" marker-end="url(#dispositor-arrow)"/>`,
+				from.x+radius*math.Cos(angle), from.y+radius*math.Sin(angle),
+				to.x-radius*math.Cos(angle), to.y-radius*math.Sin(angle),
+				theme.Dim, config.Chart.StrokeWidth))
+		}
+	}
+
+	// Planets
+	for _, row := range rows {
+		for _, name := range row {
+			position := positions[name]
+			symbol, color := dialBodySymbol(name, theme)
+
+			stroke := theme.Foreground
+			strokeWidth := config.Chart.StrokeWidth
+			if name == data.FinalDispositor {
+				stroke = theme.Fire
+				strokeWidth *= 2
+			}
+
+			elements = append(elements, fmt.Sprintf(`<circle cx="%.2f" cy="%.2f" r="%.2f" fill="%s" stroke="%s" stroke-width="%d"/>`,
+				position.x, position.y, radius, theme.Background, stroke, strokeWidth))
+			elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle" dominant-baseline="central">%s</text>`,
+				position.x, position.y, fontSize, color, symbol))
+		}
+	}
+
+	if data.Title != "" {
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle">%s</text>`,
+			size/2, float64(height)-fontSize*0.5, fontSize*0.6, theme.Dim, data.Title))
+	}
+
+	return fmt.Sprintf(`<svg height="%d" width="%d" font-family="%s" version="1.1" xmlns="http://www.w3.org/2000/svg">
+%s
+</svg>`, height, width, config.Chart.Font, strings.Join(elements, "\n"))
+}
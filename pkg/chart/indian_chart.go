@@ -0,0 +1,278 @@
+package chart
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndianChartStyle represents the square chart layouts used in Jyotish
+type IndianChartStyle string
+
+const (
+	IndianChartNorth IndianChartStyle = "north" // Fixed houses, signs rotate (diamond layout)
+	IndianChartSouth IndianChartStyle = "south" // Fixed signs, houses rotate (grid layout)
+)
+
+// IndianChartData contains the data needed to draw a square Indian chart
+type IndianChartData struct {
+	Title         string            `json:"title"`
+	AscendantSign int               `json:"ascendant_sign"` // 1-12 for Aries-Pisces
+	Bodies        []IndianChartBody `json:"bodies"`
+}
+
+// IndianChartBody represents a body placed in a sign of an Indian chart
+type IndianChartBody struct {
+	Name         string `json:"name"`
+	Sign         int    `json:"sign"` // 1-12 for Aries-Pisces
+	IsRetrograde bool   `json:"is_retrograde"`
+}
+
+// indianBodyAbbreviations contains the customary two-letter graha abbreviations
+var indianBodyAbbreviations = map[string]string{
+	"sun":     "Su",
+	"moon":    "Mo",
+	"mars":    "Ma",
+	"mercury": "Me",
+	"jupiter": "Ju",
+	"venus":   "Ve",
+	"saturn":  "Sa",
+	"rahu":    "Ra",
+	"ketu":    "Ke",
+	"uranus":  "Ur",
+	"neptune": "Ne",
+	"pluto":   "Pl",
+}
+
+// southIndianCells maps each sign (index 0-11) to its fixed column/row in the 4x4 grid
+var southIndianCells = [12][2]int{
+	{1, 0}, // Aries
+	{2, 0}, // Taurus
+	{3, 0}, // Gemini
+	{3, 1}, // Cancer
+	{3, 2}, // Leo
+	{3, 3}, // Virgo
+	{2, 3}, // Libra
+	{1, 3}, // Scorpio
+	{0, 3}, // Sagittarius
+	{0, 2}, // Capricorn
+	{0, 1}, // Aquarius
+	{0, 0}, // Pisces
+}
+
+// northIndianHouseCenters contains the label anchor of each house (1-12) in units of the chart size
+var northIndianHouseCenters = [12][2]float64{
+	{0.5, 0.25},   // 1st house (top diamond)
+	{0.25, 0.125}, // 2nd
+	{0.125, 0.25}, // 3rd
+	{0.25, 0.5},   // 4th (left diamond)
+	{0.125, 0.75}, // 5th
+	{0.25, 0.875}, // 6th
+	{0.5, 0.75},   // 7th (bottom diamond)
+	{0.75, 0.875}, // 8th
+	{0.875, 0.75}, // 9th
+	{0.75, 0.5},   // 10th (right diamond)
+	{0.875, 0.25}, // 11th
+	{0.75, 0.125}, // 12th
+}
+
+// GenerateIndianChartSVG generates a North- or South-Indian square chart
+func GenerateIndianChartSVG(data *IndianChartData, style IndianChartStyle, width int, themeType *ThemeType) (*ChartResponse, error) {
+	if data == nil {
+		return nil, fmt.Errorf("indian chart data is required")
+	}
+
+	if data.AscendantSign < 1 || data.AscendantSign > 12 {
+		return nil, fmt.Errorf("invalid ascendant sign: %d", data.AscendantSign)
+	}
+
+	if width <= 0 {
+		width = 600
+	}
+
+	config := DefaultConfig()
+	if themeType != nil {
+		config.ThemeType = *themeType
+	}
+
+	var svg string
+	switch style {
+	case IndianChartNorth:
+		svg = generateNorthIndianSVG(data, width, config)
+	case IndianChartSouth, "":
+		svg = generateSouthIndianSVG(data, width, config)
+	default:
+		return nil, fmt.Errorf("unsupported indian chart style: %s", style)
+	}
+
+	return &ChartResponse{
+		SVG:    svg,
+		Width:  width,
+		Height: width,
+	}, nil
+}
+
+// GetAvailableIndianChartStyles returns the supported square chart layouts
+func GetAvailableIndianChartStyles() []IndianChartStyle {
+	return []IndianChartStyle{IndianChartNorth, IndianChartSouth}
+}
+
+// generateNorthIndianSVG draws the diamond layout where houses are fixed and signs rotate
+func generateNorthIndianSVG(data *IndianChartData, width int, config Config) string {
+	theme := config.GetTheme()
+	size := float64(width)
+	margin := size * config.Chart.MarginFactor
+	inner := size - 2*margin
+	fontSize := inner / 32
+
+	var elements []string
+	elements = append(elements, fmt.Sprintf(`<rect x="0" y="0" width="%d" height="%d" fill="%s"/>`,
+		width, width, theme.Background))
+
+	// Outer square, both diagonals and the inner diamond joining the side midpoints
+	elements = append(elements, fmt.Sprintf(`<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="none" stroke="%s" stroke-width="%d"/>`,
+		margin, margin, inner, inner, theme.Foreground, config.Chart.StrokeWidth))
+	elements = append(elements, indianLine(margin, margin, margin+inner, margin+inner, theme.Foreground, config))
+	elements = append(elements, indianLine(margin+inner, margin, margin, margin+inner, theme.Foreground, config))
+	elements = append(elements, fmt.Sprintf(`<polygon points="%.2f,%.2f %.2f,%.2f %.2f,%.2f %.2f,%.2f" fill="none" stroke="%s" stroke-width="%d"/>`,
+		margin+inner/2, margin, margin+inner, margin+inner/2, margin+inner/2, margin+inner, margin, margin+inner/2,
+		theme.Foreground, config.Chart.StrokeWidth))
+
+	bodiesBySign := groupIndianBodies(data.Bodies)
+
+	for house := 1; house <= 12; house++ {
+		sign := (data.AscendantSign+house-2)%12 + 1
+		cx := margin + northIndianHouseCenters[house-1][0]*inner
+		cy := margin + northIndianHouseCenters[house-1][1]*inner
+
+		color := getIndianSignColor(sign, theme)
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle">%d</text>`,
+			cx, cy-fontSize*1.2, fontSize*0.85, color, sign))
+
+		labels := bodiesBySign[sign]
+		if house == 1 {
+			labels = append([]string{"Asc"}, labels...)
+		}
+		elements = append(elements, indianBodyLabels(labels, cx, cy, fontSize, theme.Foreground)...)
+	}
+
+	if data.Title != "" {
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle">%s</text>`,
+			size/2, size-margin/4, fontSize*0.8, theme.Dim, data.Title))
+	}
+
+	return indianSVGRoot(width, config, elements)
+}
+
+// generateSouthIndianSVG draws the grid layout where signs are fixed and houses rotate
+func generateSouthIndianSVG(data *IndianChartData, width int, config Config) string {
+	theme := config.GetTheme()
+	size := float64(width)
+	margin := size * config.Chart.MarginFactor
+	inner := size - 2*margin
+	cell := inner / 4
+	fontSize := inner / 32
+
+	var elements []string
+	elements = append(elements, fmt.Sprintf(`<rect x="0" y="0" width="%d" height="%d" fill="%s"/>`,
+		width, width, theme.Background))
+
+	bodiesBySign := groupIndianBodies(data.Bodies)
+
+	for i := 0; i < 12; i++ {
+		sign := i + 1
+		x := margin + float64(southIndianCells[i][0])*cell
+		y := margin + float64(southIndianCells[i][1])*cell
+
+		elements = append(elements, fmt.Sprintf(`<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="none" stroke="%s" stroke-width="%d"/>`,
+			x, y, cell, cell, theme.Foreground, config.Chart.StrokeWidth))
+
+		// Sign name in the cell corner
+		color := getIndianSignColor(sign, theme)
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s">%s</text>`,
+			x+fontSize*0.4, y+fontSize*1.1, fontSize*0.75, color, SIGN_MEMBERS[i].Symbol))
+
+		// The lagna is marked with a diagonal stroke across the cell corner
+		if sign == data.AscendantSign {
+			elements = append(elements, indianLine(x, y+cell*0.3, x+cell*0.3, y, theme.Fire, config))
+		}
+
+		labels := bodiesBySign[sign]
+		if sign == data.AscendantSign {
+			labels = append([]string{"Asc"}, labels...)
+		}
+		elements = append(elements, indianBodyLabels(labels, x+cell/2, y+cell/2, fontSize, theme.Foreground)...)
+	}
+
+	if data.Title != "" {
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle">%s</text>`,
+			size/2, size/2, fontSize*1.2, theme.Foreground, data.Title))
+	}
+
+	return indianSVGRoot(width, config, elements)
+}
+
+// groupIndianBodies groups abbreviated body labels by sign
+func groupIndianBodies(bodies []IndianChartBody) map[int][]string {
+	grouped := make(map[int][]string)
+	for _, body := range bodies {
+		label := indianBodyLabel(body.Name)
+		if body.IsRetrograde {
+			label += "(R)"
+		}
+		grouped[body.Sign] = append(grouped[body.Sign], label)
+	}
+	return grouped
+}
+
+// indianBodyLabel returns the abbreviated label for a body
+func indianBodyLabel(name string) string {
+	if abbr, exists := indianBodyAbbreviations[strings.ToLower(name)]; exists {
+		return abbr
+	}
+	if len(name) > 2 {
+		return name[:2]
+	}
+	return name
+}
+
+// indianBodyLabels stacks body labels vertically around a center point
+func indianBodyLabels(labels []string, cx, cy, fontSize float64, color string) []string {
+	var elements []string
+	lineHeight := fontSize * 1.1
+	startY := cy - lineHeight*float64(len(labels)-1)/2 + fontSize/3
+
+	for i, label := range labels {
+		elements = append(elements, fmt.Sprintf(`<text x="%.2f" y="%.2f" font-size="%.2f" fill="%s" text-anchor="middle">%s</text>`,
+			cx, startY+float64(i)*lineHeight, fontSize, color, label))
+	}
+	return elements
+}
+
+// indianLine draws a straight line
+func indianLine(x1, y1, x2, y2 float64, color string, config Config) string {
+	return fmt.Sprintf(`<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="%s" stroke-width="%d" stroke-opacity="%.2f"/>`,
+		x1, y1, x2, y2, color, config.Chart.StrokeWidth, config.Chart.StrokeOpacity)
+}
+
+// getIndianSignColor returns the element color of a sign (1-12)
+func getIndianSignColor(sign int, theme Theme) string {
+	switch SIGN_MEMBERS[(sign-1)%12].Color {
+	case "fire":
+		return theme.Fire
+	case "earth":
+		return theme.Earth
+	case "air":
+		return theme.Air
+	case "water":
+		return theme.Water
+	default:
+		return theme.Foreground
+	}
+}
+
+// indianSVGRoot wraps the chart elements in the SVG root element
+func indianSVGRoot(width int, config Config, content []string) string {
+	return fmt.Sprintf(`<svg height="%d" width="%d" font-family="%s" version="1.1" xmlns="http://www.w3.org/2000/svg">
+%s
+</svg>`, width, width, config.Chart.Font, strings.Join(content, "\n"))
+}
@@ -18,6 +18,7 @@ type RawChartData struct {
 	Ascendant   float64         `json:"ascendant"`
 	Midheaven   float64         `json:"midheaven"`
 	HouseSystem string          `json:"house_system"`
+	Aspects     []RawAspectData `json:"aspects,omitempty"` // precalculated aspects, calculated from the planets when empty
 }
 
 // RawPlanetData contains raw planet position data
@@ -27,6 +28,18 @@ type RawPlanetData struct {
 	Speed     float64 `json:"speed"`
 }
 
+// RawAspectData contains a precalculated aspect between two planets of the raw data
+type RawAspectData struct {
+	Planet1  string  `json:"planet1"`
+	Planet2  string  `json:"planet2"`
+	Type     string  `json:"type"`
+	Symbol   string  `json:"symbol,omitempty"` // glyph of custom aspects
+	Color    string  `json:"color,omitempty"`  // #rrggbb color of custom aspects
+	Orb      float64 `json:"orb"`
+	Strength float64 `json:"strength"`
+	Applying bool    `json:"applying"`
+}
+
 // GenerateNatalChartSVGFromRawData generates SVG from raw numeric data
 func GenerateNatalChartSVGFromRawData(rawData *RawChartData, width int, themeType *ThemeType) (*ChartResponse, error) {
 	if rawData == nil {
@@ -115,6 +128,37 @@ func convertRawDataToChartData(rawData *RawChartData, config Config) *ChartData
 			}
 		}
 
+		for _, member := range EXTRA_MEMBERS {
+			if !found && member.Name == normalizedName {
+				planetBody = member.Body
+				found = true
+			}
+		}
+
+		// Numbered asteroids have no glyph and are labelled with their number
+		if !found && strings.HasPrefix(normalizedName, "asteroid_") {
+			planetBody = Body{
+				Name:   normalizedName,
+				Symbol: strings.TrimPrefix(normalizedName, "asteroid_"),
+				Color:  "asteroids",
+			}
+			found = true
+		}
+
+		// Other lots have no glyph and are labelled with the first letters of their name
+		if !found && strings.HasPrefix(normalizedName, "lot_of_") && normalizedName != "lot_of_" {
+			label := []rune(strings.TrimPrefix(normalizedName, "lot_of_"))
+			if len(label) > 3 {
+				label = label[:3]
+			}
+			planetBody = Body{
+				Name:   normalizedName,
+				Symbol: strings.ToUpper(string(label[:1])) + string(label[1:]),
+				Color:  "points",
+			}
+			found = true
+		}
+
 		if !found {
 			continue
 		}
@@ -183,12 +227,53 @@ func convertRawDataToChartData(rawData *RawChartData, config Config) *ChartData
 		}
 	}
 
-	// Calculate aspects between aspectables
-	chartData.Aspects = calculateAspectsFromBodies(chartData.Aspectables, config)
+	// Use the precalculated aspects when given, otherwise calculate them between aspectables
+	if len(rawData.Aspects) > 0 {
+		chartData.Aspects = convertRawAspects(rawData.Aspects, chartData.Aspectables)
+	} else {
+		chartData.Aspects = calculateAspectsFromBodies(chartData.Aspectables, config)
+	}
 
 	return chartData
 }
 
+// convertRawAspects links precalculated aspects to the aspectable bodies, skipping
+// aspects to bodies that are not displayed
+func convertRawAspects(rawAspects []RawAspectData, aspectables []MovableBody) []Aspect {
+	aspects := make([]Aspect, 0, len(rawAspects))
+
+	for _, rawAspect := range rawAspects {
+		var body1, body2 *MovableBody
+		for i := range aspectables {
+			switch aspectables[i].Name {
+			case normalizeBodyNameForRaw(rawAspect.Planet1):
+				body1 = &aspectables[i]
+			case normalizeBodyNameForRaw(rawAspect.Planet2):
+				body2 = &aspectables[i]
+			}
+		}
+
+		if body1 == nil || body2 == nil {
+			continue
+		}
+
+		orb := rawAspect.Orb
+		strength := rawAspect.Strength
+		applying := rawAspect.Applying
+
+		aspects = append(aspects, Aspect{
+			Body1:        body1,
+			Body2:        body2,
+			AspectMember: getAspectMemberOrCustom(rawAspect.Type, rawAspect.Symbol, rawAspect.Color),
+			Applying:     &applying,
+			Orb:          &orb,
+			Strength:     &strength,
+		})
+	}
+
+	return aspects
+}
+
 // normalizeAngle360 ensures angle is between 0 and 360 degrees
 func normalizeAngle360(angle float64) float64 {
 	for angle < 0 {
@@ -230,10 +315,27 @@ func normalizeBodyNameForRaw(name string) string {
 		return "pluto"
 	case "north node":
 		return "asc_node"
+	case "true node":
+		return "true_node"
+	case "south node":
+		return "dsc_node"
+	case "osculating lilith":
+		return "osculating_lilith"
 	case "chiron":
 		return "chiron"
+	case "ascendant":
+		return "asc"
+	case "midheaven":
+		return "mc"
+	case "descendant":
+		return "dsc"
+	case "co-ascendant (koch)":
+		return "co_ascendant_koch"
+	case "co-ascendant (munkasey)":
+		return "co_ascendant_munkasey"
 	default:
-		return strings.ToLower(strings.TrimSpace(name))
+		// "Asteroid 433" becomes "asteroid_433"
+		return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
 	}
 }
 
@@ -264,6 +366,10 @@ func shouldDisplayBodyByName(name string, display Display) bool {
 		return display.AscNode
 	case "chiron":
 		return display.Chiron
+	case "ceres", "pallas", "juno", "vesta", "true_node", "dsc_node", "lilith", "osculating_lilith",
+		"lot_of_fortune", "vertex", "east_point", "co_ascendant_koch", "co_ascendant_munkasey", "polar_ascendant":
+		// Optional bodies, sensitive points and lots are only present in raw data when explicitly requested
+		return true
 	case "asc":
 		return display.Asc
 	case "ic":
@@ -273,7 +379,8 @@ func shouldDisplayBodyByName(name string, display Display) bool {
 	case "mc":
 		return display.MC
 	default:
-		return false
+		normalized := normalizeBodyNameForRaw(name)
+		return strings.HasPrefix(normalized, "asteroid_") || strings.HasPrefix(normalized, "lot_of_")
 	}
 }
 
@@ -0,0 +1,82 @@
+// Package geojson provides the GeoJSON (RFC 7946) types used to return map data
+package geojson
+
+// GeoJSON object types
+const (
+	TypeFeatureCollection = "FeatureCollection"
+	TypeFeature           = "Feature"
+	TypePoint             = "Point"
+	TypeLineString        = "LineString"
+	TypeMultiLineString   = "MultiLineString"
+)
+
+// Position is a [longitude, latitude] pair in degrees
+type Position [2]float64
+
+// FeatureCollection is a list of features
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a geometry with its properties
+type Feature struct {
+	Type       string                 `json:"type"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Geometry holds the coordinates of a point, line or set of lines
+type Geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// NewFeatureCollection creates an empty feature collection
+func NewFeatureCollection() *FeatureCollection {
+	return &FeatureCollection{
+		Type:     TypeFeatureCollection,
+		Features: []Feature{},
+	}
+}
+
+// AddFeature appends a feature to the collection
+func (fc *FeatureCollection) AddFeature(feature Feature) {
+	fc.Features = append(fc.Features, feature)
+}
+
+// NewFeature creates a feature from a geometry and its properties
+func NewFeature(geometry Geometry, properties map[string]interface{}) Feature {
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	return Feature{
+		Type:       TypeFeature,
+		Geometry:   geometry,
+		Properties: properties,
+	}
+}
+
+// NewPoint creates a point geometry
+func NewPoint(longitude, latitude float64) Geometry {
+	return Geometry{
+		Type:        TypePoint,
+		Coordinates: Position{longitude, latitude},
+	}
+}
+
+// NewLineString creates a line geometry
+func NewLineString(positions []Position) Geometry {
+	return Geometry{
+		Type:        TypeLineString,
+		Coordinates: positions,
+	}
+}
+
+// NewMultiLineString creates a geometry made of several lines, e.g. a line split at the antimeridian
+func NewMultiLineString(lines [][]Position) Geometry {
+	return Geometry{
+		Type:        TypeMultiLineString,
+		Coordinates: lines,
+	}
+}